@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type ServiceAccountSelector struct {
 
 	// Name specifies the name of the service account to be selected.
@@ -32,3 +36,70 @@ type SecretKeySelector struct {
 	// +required
 	Namespace string `json:"namespace"`
 }
+
+// ConfigMapKeySelector is used to reference a specific key of a ConfigMap within a Kubernetes namespace.
+type ConfigMapKeySelector struct {
+
+	// Name specifies the name of the referenced Kubernetes ConfigMap.
+	// +required
+	Name string `json:"name"`
+
+	// Key specifies the key within the referenced Kubernetes ConfigMap.
+	// +required
+	Key string `json:"key"`
+
+	// Namespace specifies the Kubernetes namespace where the referenced ConfigMap resides.
+	// +required
+	Namespace string `json:"namespace"`
+}
+
+// TLSConfig configures TLS for connections made by notification sources.
+type TLSConfig struct {
+	// CARef references a PEM encoded CA bundle used to verify the server certificate.
+	// If not set, the system roots are used.
+	// +optional
+	CARef *SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// CertRef references a PEM encoded client certificate used for mutual TLS.
+	// Requires KeyRef.
+	// +optional
+	CertRef *SecretKeySelector `json:"certSecretRef,omitempty"`
+
+	// KeyRef references the PEM encoded private key of the client certificate.
+	// Requires CertRef.
+	// +optional
+	KeyRef *SecretKeySelector `json:"keySecretRef,omitempty"`
+
+	// ServerName overrides the server name used to verify the server certificate.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables server certificate verification. Use only for testing.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// PollConfig configures how polling notification sources check for secret changes.
+type PollConfig struct {
+	// Interval is the time between two polls. Defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Jitter is the maximum random delay added to every interval, so that several replicas
+	// or sources do not poll the provider at the same time.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// Concurrency is the maximum number of concurrent requests made to the provider during a poll. Defaults to 4.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// StateConfigMapRef references the ConfigMap key where known secret versions are stored.
+	// The ConfigMap is created if it does not exist. With a stored state, restarts do not fire events again
+	// and changes made while the reloader was down are detected on the first poll.
+	// If not set, the state is only kept in memory and the first poll records the current versions without firing events.
+	// Large states are split across ConfigMaps named after it with a `-1`, `-2`... suffix.
+	// +optional
+	StateConfigMapRef *ConfigMapKeySelector `json:"stateConfigMapRef,omitempty"`
+}
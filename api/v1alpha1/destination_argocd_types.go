@@ -0,0 +1,46 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ArgoCDRefreshType is the kind of refresh requested on an Argo CD Application.
+// +kubebuilder:validation:Enum=Normal;Hard
+type ArgoCDRefreshType string
+
+const (
+	// ArgoCDRefreshNormal compares the live state against the cached manifests.
+	ArgoCDRefreshNormal ArgoCDRefreshType = "Normal"
+	// ArgoCDRefreshHard also regenerates the manifests.
+	ArgoCDRefreshHard ArgoCDRefreshType = "Hard"
+)
+
+// Defines an ArgoCDApplicationDestination for `argoproj.io/v1alpha1` Applications.
+// Default UpdateStrategy is setting the `argocd.argoproj.io/refresh` annotation, and optionally starting a sync operation.
+// Default MatchStrategy is to always match, as Applications usually reference secrets indirectly.
+// Default WaitStrategy is to wait until the refresh is done, the sync operation succeeded if requested,
+// and the Application is Healthy, failing as soon as the sync fails or the Application is Degraded.
+type ArgoCDApplicationDestination struct {
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// Refresh is the kind of refresh requested.
+	// +kubebuilder:default=Normal
+	// +optional
+	Refresh ArgoCDRefreshType `json:"refresh,omitempty"`
+
+	// Sync also starts a sync operation. Applications with an operation in progress are only refreshed.
+	// +optional
+	Sync bool `json:"sync,omitempty"`
+}
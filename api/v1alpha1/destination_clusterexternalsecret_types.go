@@ -0,0 +1,27 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines a ClusterExternalSecretDestination.
+// Default UpdateStrategy is setting the `external-secrets.io/force-sync` annotation on the ClusterExternalSecret,
+// which ESO mirrors to the ExternalSecrets it produces to trigger their refresh.
+// If RefreshExternalSecrets is set, the ExternalSecrets it produces in `status.provisionedNamespaces` are refreshed directly instead.
+// Default MatchStrategy is the same as ExternalSecretDestination, against `spec.externalSecretSpec`.
+// WaitFor waits for the ExternalSecrets it produces to sync, then for the ClusterExternalSecret to be Ready.
+type ClusterExternalSecretDestination struct {
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// RefreshExternalSecrets refreshes the ExternalSecrets produced by the ClusterExternalSecret directly,
+	// instead of changing the ClusterExternalSecret.
+	// +optional
+	RefreshExternalSecrets bool `json:"refreshExternalSecrets,omitempty"`
+}
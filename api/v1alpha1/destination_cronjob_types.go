@@ -0,0 +1,33 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines a CronJobDestination. Behavior is a job templates pod annotations patch.
+// Default UpdateStrategy is a `spec.jobTemplate.spec.template` annotations patch, so the next scheduled Job
+// starts with the rotated credentials.
+// Default MatchStrategy is matching secret-key with any of:
+// * Equality against `env[*].valueFrom.secretKeyRef.name` or `env[*].valueFrom.configMapKeyRef.name` of any container
+// * Equality against `envFrom.secretRef.name` or `envFrom.configMapRef.name` of any container
+// * Equality against the secret and configMap volumes, including projected ones, of the pod template
+// Default WaitStrategy is to not wait.
+type CronJobDestination struct {
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// RunNow also creates a one-off Job from the CronJob, like `kubectl create job --from=cronjob/<name>`.
+	// +optional
+	RunNow bool `json:"runNow,omitempty"`
+}
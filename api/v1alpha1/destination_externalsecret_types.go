@@ -3,11 +3,13 @@ package v1alpha1
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 // Defines an ExternalSecretDestination. Behavior is an annotations patch.
-// Default UpdateStrategy is annotations patch to trigger externalSecret reconcile.
+// Default UpdateStrategy is setting the `external-secrets.io/force-sync` annotation to trigger externalSecret refresh.
 // Default MatchStrategy is matching secret-key with any of:
 // * Equality against `spec.data.remoteRef.key`
 // * Equality against `spec.dataFrom.remoteRef.key`
 // * Regexp against `spec.dataFrom.find.name.regexp`
+// WaitFor waits for `status.syncedResourceVersion` to change from before the refresh request and the Ready condition to be true.
+// A SecretSyncedError marks the reload as failed.
 type ExternalSecretDestination struct {
 	// NamespaceSelectors selects namespaces based on labels.
 	// The manifest must reside in a namespace that matches at least one of these selectors.
@@ -24,4 +26,10 @@ type ExternalSecretDestination struct {
 	// The resource must have a name that matches one of these entries.
 	// +optional
 	Names []string `json:"names,omitempty"`
+
+	// RespectRefreshPolicy skips ExternalSecrets that ESO does not refresh once synced,
+	// i.e. with the `CreatedOnce` refreshPolicy, or the `Periodic` one and a zero refreshInterval.
+	// Otherwise they are annotated, but not waited on.
+	// +optional
+	RespectRefreshPolicy bool `json:"respectRefreshPolicy,omitempty"`
 }
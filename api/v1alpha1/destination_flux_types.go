@@ -0,0 +1,41 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// FluxKind is the kind of Flux object to reconcile.
+// +kubebuilder:validation:Enum=Kustomization;HelmRelease
+type FluxKind string
+
+const (
+	// FluxKindKustomization selects `kustomize.toolkit.fluxcd.io/v1` Kustomizations.
+	FluxKindKustomization FluxKind = "Kustomization"
+	// FluxKindHelmRelease selects `helm.toolkit.fluxcd.io/v2` HelmReleases.
+	FluxKindHelmRelease FluxKind = "HelmRelease"
+)
+
+// Defines a FluxDestination. Behavior is a reconcile request, like `flux reconcile`.
+// Default UpdateStrategy is setting the `reconcile.fluxcd.io/requestedAt` annotation.
+// Default MatchStrategy is to always match, as Flux objects usually reference secrets indirectly.
+// Default WaitStrategy is to wait until the request is handled and the object is Ready,
+// failing as soon as it reports Ready=False or Stalled=True.
+type FluxDestination struct {
+	// Kind of the Flux objects to reconcile.
+	// +required
+	Kind FluxKind `json:"kind"`
+
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+}
@@ -0,0 +1,41 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines a GenericDestination for objects of any kind, e.g. Knative Services, KEDA ScaledObjects or in-house CRDs.
+// The reloader ServiceAccount needs get, list, update and patch permissions on the kind, granted by the operator.
+// Default UpdateStrategy is an annotations patch. If set, UpdateStrategy is used instead:
+// * Patch sets the rendered PatchOperationConfig.Template at PatchOperationConfig.Path
+// * PatchStatus does the same through the status subresource
+// * Delete deletes the object
+// The template is a Go template rendered with the event fields SecretIdentifier, RotationTimestamp, TriggerSource
+// and Namespace. A rendered JSON value is set as is, anything else as a string.
+// MatchStrategy is required, as nothing else ties an object of an arbitrary kind to the rotated secret:
+// without it, every listed object would be patched or deleted on every event. It is evaluated against the object.
+// Condition values are Go templates rendered with SecretIdentifier, e.g. `{{ .SecretIdentifier }}`.
+// Default WaitStrategy is to not wait. If set, WaitStrategy is used.
+type GenericDestination struct {
+	// APIVersion of the objects, e.g. `serving.knative.dev/v1`.
+	// +required
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the objects, e.g. `Service`.
+	// +required
+	Kind string `json:"kind"`
+
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+}
@@ -0,0 +1,69 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines an HttpCallbackDestination, for consumers outside of Kubernetes. Behavior is an HTTP POST per event.
+// Default UpdateStrategy is a POST of the rendered body to URL. Any 2xx response is a success.
+// Default MatchStrategy is to match every event. A MatchStrategy is evaluated against the event fields
+// `secretIdentifier`, `rotationTimestamp`, `triggerSource` and `namespace`, e.g. a path of `.secretIdentifier`.
+// Default WaitStrategy is to not wait.
+type HttpCallbackDestination struct {
+	// URL the events are posted to.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +required
+	URL string `json:"url"`
+
+	// BodyTemplate is a Go template rendered with the event, e.g. `{"secret": "{{ .SecretIdentifier }}"}`.
+	// The event fields are SecretIdentifier, RotationTimestamp, TriggerSource and Namespace.
+	// Defaults to the event encoded as JSON.
+	// +optional
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+
+	// ContentType of the body. Defaults to application/json.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// Headers added to every request, e.g. an Authorization header read from a secret.
+	// +optional
+	Headers []HttpCallbackHeader `json:"headers,omitempty"`
+
+	// TLS configures the CA bundle and the client certificate for mutual TLS.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// Timeout of a single request. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retry configures how failed requests are retried.
+	// +optional
+	Retry *HttpCallbackRetry `json:"retry,omitempty"`
+}
+
+// HttpCallbackHeader is a header set on callback requests. Exactly one of Value and ValueFrom must be set.
+type HttpCallbackHeader struct {
+	// Name of the header.
+	// +required
+	Name string `json:"name"`
+
+	// Value of the header.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom references a secret key holding the value of the header.
+	// +optional
+	ValueFrom *SecretKeySelector `json:"valueFrom,omitempty"`
+}
+
+// HttpCallbackRetry configures retries of callback requests.
+// Network errors, 429 and 5xx responses are retried with an exponential backoff. Other responses are not retried.
+type HttpCallbackRetry struct {
+	// MaxAttempts is the maximum number of requests made per event. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+
+	// Backoff is the delay before the first retry. It doubles on every retry. Defaults to 1s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
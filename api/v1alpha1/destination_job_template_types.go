@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobTemplateInjection defines how the event fields are passed to the created Job.
+// +kubebuilder:validation:Enum=Env;Annotations
+type JobTemplateInjection string
+
+const (
+	// JobTemplateInjectionEnv adds RELOADER_SECRET_IDENTIFIER, RELOADER_ROTATION_TIMESTAMP, RELOADER_TRIGGER_SOURCE
+	// and RELOADER_NAMESPACE env vars to every container.
+	JobTemplateInjectionEnv JobTemplateInjection = "Env"
+	// JobTemplateInjectionAnnotations adds `reloader.external-secrets.io/secret-identifier`, `rotation-timestamp`,
+	// `trigger-source` and `namespace` annotations to the Job and its pod template, e.g. for the downward API.
+	JobTemplateInjectionAnnotations JobTemplateInjection = "Annotations"
+)
+
+// Defines a JobTemplateDestination, to run an action on rotation. Behavior is creating a Job per event.
+// Exactly one of Template and TemplateRef must be set.
+// Default UpdateStrategy is creating a Job from the template, named after Name and the event.
+// Default MatchStrategy is to match every event. A MatchStrategy is evaluated against the event fields
+// `secretIdentifier`, `rotationTimestamp`, `triggerSource` and `namespace`, e.g. a path of `.secretIdentifier`.
+// Default WaitStrategy is to wait for the Job to complete, failing as soon as the Job fails.
+// +kubebuilder:validation:XValidation:rule="has(self.__namespace__) || has(self.namespaceSelectors)",message="one of namespace and namespaceSelectors is required"
+type JobTemplateDestination struct {
+	// Name is the prefix of the created Jobs.
+	// +kubebuilder:validation:MaxLength=52
+	// +required
+	Name string `json:"name"`
+
+	// Namespace the Jobs are created in. Defaults to the namespace of the event, if it matches NamespaceSelectors.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// NamespaceSelectors restricts the event namespaces Jobs are created in when Namespace is not set.
+	// The namespace of an event is set by its sender, so it must match at least one of these selectors.
+	// Events from other namespaces are ignored.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// Template of the created Jobs.
+	// +optional
+	Template *JobTemplate `json:"template,omitempty"`
+
+	// TemplateRef references a ConfigMap key holding a JobTemplate as YAML.
+	// +optional
+	TemplateRef *ConfigMapKeySelector `json:"templateRef,omitempty"`
+
+	// InjectAs defines how the event fields are passed to the Job.
+	// +kubebuilder:default=Env
+	// +optional
+	InjectAs JobTemplateInjection `json:"injectAs,omitempty"`
+
+	// HistoryLimit is the number of finished Jobs kept per destination. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+
+	// Timeout of the wait for the Job to complete. Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// JobTemplate describes the Jobs created by a JobTemplateDestination.
+type JobTemplate struct {
+	// Labels added to the Jobs.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the Jobs.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec of the Jobs.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +required
+	Spec batchv1.JobSpec `json:"spec"`
+}
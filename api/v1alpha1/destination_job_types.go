@@ -0,0 +1,36 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines a JobDestination. As the pod template of a Job is immutable, behavior is to delete running Jobs
+// and recreate them from their spec under a new name suffix, if Recreate is set. Finished Jobs are left untouched,
+// and so are Jobs controlled by a CronJob: a CronJob destination updates the template of their next runs.
+// Jobs created by JobTemplate destinations are not recreated either.
+// Default MatchStrategy is matching secret-key with any of:
+// * Equality against `env[*].valueFrom.secretKeyRef.name` or `env[*].valueFrom.configMapKeyRef.name` of any container
+// * Equality against `envFrom.secretRef.name` or `envFrom.configMapRef.name` of any container
+// * Equality against the secret and configMap volumes, including projected ones, of the pod template
+// Default WaitStrategy is to not wait.
+type JobDestination struct {
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// Recreated Jobs are matched by the name they were originally created with.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// Recreate opts in to deleting and recreating the running Jobs, which restarts their work from scratch.
+	// Without it, no Job is touched.
+	// +optional
+	Recreate bool `json:"recreate,omitempty"`
+}
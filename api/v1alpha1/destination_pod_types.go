@@ -0,0 +1,45 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Defines a PodDestination, for Pods without a pod template to patch, e.g. bare Pods or Pods created by operators.
+// Default UpdateStrategy is evicting the Pods through the Eviction API, so PodDisruptionBudgets are respected.
+// Evicted bare Pods are not recreated.
+// Default MatchStrategy is matching secret-key with any of:
+// * Equality against `env[*].valueFrom.secretKeyRef.name` or `env[*].valueFrom.configMapKeyRef.name` of any container
+// * Equality against `envFrom.secretRef.name` or `envFrom.configMapRef.name` of any container
+// * Equality against the secret and configMap volumes, including projected ones, of the Pod
+// Default WaitStrategy is to not wait, unless WaitForReplacement is set.
+type PodDestination struct {
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// MaxUnavailable is the maximum number of Pods of the same controller that may be unavailable
+	// before another one is evicted. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty"`
+
+	// WaitForReplacement waits, after every eviction, until the controller of the Pod has as many Ready Pods as before.
+	// Pods without a controller are not waited for.
+	// +optional
+	WaitForReplacement bool `json:"waitForReplacement,omitempty"`
+
+	// Timeout of every wait, for an eviction blocked by a PodDisruptionBudget, for MaxUnavailable
+	// or for the replacement Pods. Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
@@ -0,0 +1,50 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RolloutUpdateMethod defines how an Argo Rollout is restarted.
+// +kubebuilder:validation:Enum=PodTemplateAnnotations;RestartAt
+type RolloutUpdateMethod string
+
+const (
+	// RolloutUpdateMethodPodTemplateAnnotations patches the pod template annotations, starting a new revision.
+	RolloutUpdateMethodPodTemplateAnnotations RolloutUpdateMethod = "PodTemplateAnnotations"
+	// RolloutUpdateMethodRestartAt sets `spec.restartAt`, restarting the pods of the current revision.
+	RolloutUpdateMethodRestartAt RolloutUpdateMethod = "RestartAt"
+)
+
+// Defines a RolloutDestination for `argoproj.io/v1alpha1` Rollouts.
+// Default UpdateStrategy is pod template annotations patch to trigger a new rollout.
+// Default MatchStrategy is matching secret-key with any of:
+// * Equality against `env[*].valueFrom.secretKeyRef.name` or `env[*].valueFrom.configMapKeyRef.name` of any container
+// * Equality against `envFrom.secretRef.name` or `envFrom.configMapRef.name` of any container
+// * Equality against the secret and configMap volumes, including projected ones, of `spec.template`
+// Rollouts using `spec.workloadRef` have no template and are never matched.
+// Default WaitStrategy is to follow `status.phase` until the Rollout is Healthy, failing as soon as it is aborted or degraded.
+type RolloutDestination struct {
+	// NamespaceSelectors selects namespaces based on labels.
+	// The manifest must reside in a namespace that matches at least one of these selectors.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// LabelSelectors selects resources based on their labels.
+	// The resource must satisfy all conditions defined in this selector.
+	// Supports both matchLabels and matchExpressions for advanced filtering.
+	// +optional
+	LabelSelectors *metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Names specifies a list of resource names to watch.
+	// The resource must have a name that matches one of these entries.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// UpdateMethod defines how the Rollout is restarted.
+	// +kubebuilder:default=PodTemplateAnnotations
+	// +optional
+	UpdateMethod RolloutUpdateMethod `json:"updateMethod,omitempty"`
+
+	// WaitForPromotion keeps waiting while a canary is paused, until it is promoted and Healthy.
+	// By default, reaching a paused canary step ends the wait.
+	// +optional
+	WaitForPromotion bool `json:"waitForPromotion,omitempty"`
+}
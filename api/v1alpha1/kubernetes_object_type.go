@@ -13,6 +13,17 @@ type KubernetesObjectConfig struct {
 
 	// LabelSelector can be used to identify and narrow down secrets for watching.
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Namespace limits the watch to a single namespace. If empty, all namespaces are watched.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// StateConfigMapRef references the ConfigMap key where the last seen versions are stored.
+	// If not set, the versions are only kept in memory: after a restart, every object is seen for the first time
+	// and only recorded, so a change that happens while the reloader is down or starting fires no event.
+	// Large states are split across ConfigMaps named after it with a `-1`, `-2`... suffix.
+	// +optional
+	StateConfigMapRef *ConfigMapKeySelector `json:"stateConfigMapRef,omitempty"`
 }
 
 type KubernetesAuth struct {
@@ -33,8 +33,8 @@ type ConfigSpec struct {
 
 // NotificationSource represents a notification system configuration.
 type NotificationSource struct {
-	// Type of the notification source (e.g., AwsSqs, AzureEventGrid, GooglePubSub, HashicorpVault, Webhook, TCPSocket, KubernetesSecret).
-	// +kubebuilder:validation:Enum=AwsSqs;AzureEventGrid;GooglePubSub;HashicorpVault;Webhook;TCPSocket;KubernetesSecret
+	// Type of the notification source (e.g., AwsSqs, AzureEventGrid, GooglePubSub, HashicorpVault, Webhook, TCPSocket, KubernetesSecret, KubernetesConfigMap, KubernetesObject, CloudEvents, Kafka, Nats, Redis, Amqp, Mqtt, AwsSecretsManagerPoll, GoogleSecretManagerPoll, HashicorpVaultPoll, AzureKeyVaultPoll).
+	// +kubebuilder:validation:Enum=AwsSqs;AzureEventGrid;GooglePubSub;HashicorpVault;Webhook;TCPSocket;KubernetesSecret;KubernetesConfigMap;KubernetesObject;CloudEvents;Kafka;Nats;Redis;Amqp;Mqtt;AwsSecretsManagerPoll;GoogleSecretManagerPoll;HashicorpVaultPoll;AzureKeyVaultPoll
 	// +required
 	Type string `json:"type"`
 
@@ -64,19 +64,64 @@ type NotificationSource struct {
 	// +optional
 	KubernetesConfigMap *KubernetesConfigMapConfig `json:"kubernetesConfigMap,omitempty"`
 
+	// Kubernetes object watch configuration (required if Type is KubernetesObject).
+	// +optional
+	KubernetesObject *KubernetesObjectWatchConfig `json:"kubernetesObject,omitempty"`
+
 	// TCPSocket configuration (required if Type is TCPSocket).
 	// +optional
 	TCPSocket *TCPSocketConfig `json:"tcpSocket,omitempty"`
 
+	// CloudEvents configuration (required if Type is CloudEvents).
+	// +optional
+	CloudEvents *CloudEventsConfig `json:"cloudEvents,omitempty"`
+
+	// Kafka configuration (required if Type is Kafka).
+	// +optional
+	Kafka *KafkaConfig `json:"kafka,omitempty"`
+
+	// Nats configuration (required if Type is Nats).
+	// +optional
+	Nats *NatsConfig `json:"nats,omitempty"`
+
+	// Redis configuration (required if Type is Redis).
+	// +optional
+	Redis *RedisConfig `json:"redis,omitempty"`
+
+	// Amqp configuration (required if Type is Amqp).
+	// +optional
+	Amqp *AmqpConfig `json:"amqp,omitempty"`
+
+	// Mqtt configuration (required if Type is Mqtt).
+	// +optional
+	Mqtt *MqttConfig `json:"mqtt,omitempty"`
+
+	// AwsSecretsManagerPoll configuration (required if Type is AwsSecretsManagerPoll).
+	// +optional
+	AwsSecretsManagerPoll *AWSSecretsManagerPollConfig `json:"awsSecretsManagerPoll,omitempty"`
+
+	// GoogleSecretManagerPoll configuration (required if Type is GoogleSecretManagerPoll).
+	// +optional
+	GoogleSecretManagerPoll *GoogleSecretManagerPollConfig `json:"googleSecretManagerPoll,omitempty"`
+
+	// HashicorpVaultPoll configuration (required if Type is HashicorpVaultPoll).
+	// +optional
+	HashicorpVaultPoll *HashicorpVaultPollConfig `json:"hashicorpVaultPoll,omitempty"`
+
+	// AzureKeyVaultPoll configuration (required if Type is AzureKeyVaultPoll).
+	// +optional
+	AzureKeyVaultPoll *AzureKeyVaultPollConfig `json:"azureKeyVaultPoll,omitempty"`
+
 	// Mock configuration (optional field for testing purposes).
 	Mock *MockConfig `json:"mock,omitempty"`
 }
 
 // DestinationToWatch specifies the criteria for monitoring secrets in the cluster.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Generic' || has(self.matchStrategy)",message="matchStrategy is required for Generic destinations"
 type DestinationToWatch struct {
 	// Type specifies the type of destination to watch.
 	// +required
-	// +kubebuilder:validation:Enum=ExternalSecret;Deployment;PushSecret;WorkflowRunTemplate
+	// +kubebuilder:validation:Enum=ExternalSecret;Deployment;PushSecret;WorkflowRunTemplate;CronJob;Job;Rollout;Flux;ArgoCDApplication;HttpCallback;JobTemplate;Pod;Generic;ClusterExternalSecret
 	Type string `json:"type"`
 	// +optional
 	WorkflowRunTemplate *WorkflowRunTemplateDestination `json:"workflowRunTemplate,omitempty"`
@@ -86,6 +131,26 @@ type DestinationToWatch struct {
 	PushSecret *PushSecretDestination `json:"pushSecret,omitempty"`
 	// +optional
 	Deployment *DeploymentDestination `json:"deployment,omitempty"`
+	// +optional
+	CronJob *CronJobDestination `json:"cronJob,omitempty"`
+	// +optional
+	Job *JobDestination `json:"job,omitempty"`
+	// +optional
+	Rollout *RolloutDestination `json:"rollout,omitempty"`
+	// +optional
+	Flux *FluxDestination `json:"flux,omitempty"`
+	// +optional
+	ArgoCDApplication *ArgoCDApplicationDestination `json:"argoCDApplication,omitempty"`
+	// +optional
+	HttpCallback *HttpCallbackDestination `json:"httpCallback,omitempty"`
+	// +optional
+	JobTemplate *JobTemplateDestination `json:"jobTemplate,omitempty"`
+	// +optional
+	Pod *PodDestination `json:"pod,omitempty"`
+	// +optional
+	Generic *GenericDestination `json:"generic,omitempty"`
+	// +optional
+	ClusterExternalSecret *ClusterExternalSecretDestination `json:"clusterExternalSecret,omitempty"`
 	//UpdateStrategy. If not specified, will use each destinations' default update strategy.
 	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
 	//MatchStrategy. If not specified, will use each destinations' default match strategy.
@@ -94,6 +159,27 @@ type DestinationToWatch struct {
 	//WaitStrategy. If not specified, will use each destinations's default wait strategy.
 	// +optional
 	WaitStrategy *WaitStrategy `json:"waitStrategy,omitempty"`
+	// Cluster is the remote cluster the destination is filtered and applied in.
+	// If not specified, the cluster the reloader runs in is used.
+	// +optional
+	Cluster *DestinationCluster `json:"cluster,omitempty"`
+}
+
+// DestinationCluster references a remote cluster for a destination.
+// +kubebuilder:validation:XValidation:rule="has(self.auth)",message="auth is required"
+type DestinationCluster struct {
+	// Name identifies the cluster in the status.
+	// +required
+	Name string `json:"name"`
+
+	// Server URL of the cluster. Not needed if Auth uses a KubeConfigRef.
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// How to authenticate with the cluster. Required, as the default config of the reloader
+	// would target the cluster it runs in.
+	// +optional
+	Auth *KubernetesAuth `json:"auth,omitempty"`
 }
 
 // ConfigStatus defines the observed state of Reloader
@@ -101,6 +187,31 @@ type ConfigStatus struct {
 	// Conditions represent the latest available observations of the resource's state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Clusters reports the health of the remote clusters used by destinations.
+	// +optional
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+}
+
+// ClusterStatus is the health of a remote destination cluster.
+type ClusterStatus struct {
+	// Name of the cluster.
+	Name string `json:"name"`
+
+	// ID identifies the cluster configuration, as clusters of different destinations may share a name.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Ready is true if the cluster API server is reachable with the configured auth.
+	Ready bool `json:"ready"`
+
+	// Message explains why the cluster is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time Ready changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
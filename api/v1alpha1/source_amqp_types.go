@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AmqpConfig contains configuration for consuming secret change events from an AMQP 0-9-1 broker such as RabbitMQ.
+type AmqpConfig struct {
+	// URL is the broker URL, e.g. `amqp://rabbitmq:5672/vhost`. Use the `amqps` scheme for TLS.
+	// Credentials should be set through UsernameRef and PasswordRef rather than in the URL.
+	// +required
+	URL string `json:"url"`
+
+	// UsernameRef references the username used to authenticate.
+	// +optional
+	UsernameRef *SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordRef references the password used to authenticate.
+	// +optional
+	PasswordRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// TLS configures TLS for the broker connection.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// Queue is the name of the queue to consume from.
+	// +required
+	Queue string `json:"queue"`
+
+	// DeclareQueue declares the queue as durable if it does not exist.
+	// +optional
+	DeclareQueue bool `json:"declareQueue,omitempty"`
+
+	// Exchange optionally binds the queue to an exchange.
+	// +optional
+	Exchange *AmqpExchange `json:"exchange,omitempty"`
+
+	// Prefetch is the maximum number of unacknowledged messages delivered to the reloader.
+	// +kubebuilder:default=10
+	// +optional
+	Prefetch int32 `json:"prefetch,omitempty"`
+
+	// ReconnectDelay is how long to wait between reconnection attempts. Defaults to 5s.
+	// +optional
+	ReconnectDelay *metav1.Duration `json:"reconnectDelay,omitempty"`
+
+	// SecretIdentifierOnPayload is the gjson path to the secret identifier in the message body.
+	// It accepts gjson queries returning arrays (e.g. `records.#.name`), in which case one event is emitted per element.
+	// +required
+	SecretIdentifierOnPayload string `json:"identifierPathOnPayload"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the message body.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the message body.
+	// If not set or not found, the time the message was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the message body.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+}
+
+// AmqpExchange contains configuration to bind the queue to an exchange.
+type AmqpExchange struct {
+	// Name is the name of the exchange.
+	// +required
+	Name string `json:"name"`
+
+	// Type is the exchange type, used when the exchange is declared.
+	// +kubebuilder:validation:Enum=direct;fanout;topic;headers
+	// +kubebuilder:default=topic
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Declare declares the exchange as durable if it does not exist.
+	// +optional
+	Declare bool `json:"declare,omitempty"`
+
+	// RoutingKeys are the binding keys between the exchange and the queue.
+	// If empty, the queue is bound with an empty routing key.
+	// +optional
+	RoutingKeys []string `json:"routingKeys,omitempty"`
+}
@@ -0,0 +1,25 @@
+package v1alpha1
+
+// AWSSecretsManagerPollConfig contains configuration for polling AWS Secrets Manager for secret changes.
+// A secret is reported as changed when its AWSCURRENT version or its last changed date differs from the known one.
+type AWSSecretsManagerPollConfig struct {
+	// Authentication methods for AWS.
+	// +required
+	Auth AWSSDKAuth `json:"auth"`
+
+	// NamePrefix limits polling to secrets whose name starts with the given prefix.
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// Tags limits polling to secrets having all the given tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Endpoint overrides the AWS Secrets Manager endpoint, e.g. for VPC endpoints or local stand-ins.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Poll configures the poll interval and where known versions are stored.
+	// +optional
+	Poll *PollConfig `json:"poll,omitempty"`
+}
@@ -0,0 +1,72 @@
+package v1alpha1
+
+// AzureKeyVaultPollConfig contains configuration for polling Azure Key Vault for new secret versions.
+// A secret is reported as changed when the ID of its current version differs from the known one.
+// Use it for Key Vaults behind private endpoints where Event Grid cannot deliver notifications.
+type AzureKeyVaultPollConfig struct {
+	// VaultURL is the URL of the Key Vault, e.g. `https://my-vault.vault.azure.net`.
+	// +required
+	VaultURL string `json:"vaultURL"`
+
+	// Auth is the authentication method for Azure Key Vault.
+	// +required
+	Auth AzureKeyVaultAuth `json:"auth"`
+
+	// Names limits polling to the given secret names.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// Tags limits polling to secrets having all the given tags.
+	// If neither Names nor Tags are set, all secrets of the Key Vault are polled.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Poll configures the poll interval, concurrency and where known versions are stored.
+	// +optional
+	Poll *PollConfig `json:"poll,omitempty"`
+}
+
+// AzureKeyVaultAuth contains the authentication methods for Azure. Exactly one of ClientSecret or WorkloadIdentity must be set.
+type AzureKeyVaultAuth struct {
+	// TenantID is the Microsoft Entra tenant ID.
+	// Required for ClientSecret; for WorkloadIdentity it defaults to the `AZURE_TENANT_ID` environment variable.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// AuthorityHost overrides the Microsoft Entra authority host, e.g. for sovereign clouds.
+	// +optional
+	AuthorityHost string `json:"authorityHost,omitempty"`
+
+	// ClientSecret authenticates with a service principal client ID and secret.
+	// +optional
+	ClientSecret *AzureClientSecretAuth `json:"clientSecret,omitempty"`
+
+	// WorkloadIdentity authenticates with workload identity federation using a ServiceAccount token.
+	// +optional
+	WorkloadIdentity *AzureWorkloadIdentityAuth `json:"workloadIdentity,omitempty"`
+}
+
+// AzureClientSecretAuth references the credentials of a service principal.
+type AzureClientSecretAuth struct {
+	// ClientIDRef references the client ID of the service principal.
+	// +required
+	ClientIDRef SecretKeySelector `json:"clientIDSecretRef"`
+
+	// ClientSecretRef references the client secret of the service principal.
+	// +required
+	ClientSecretRef SecretKeySelector `json:"clientSecretSecretRef"`
+}
+
+// AzureWorkloadIdentityAuth configures workload identity federation.
+type AzureWorkloadIdentityAuth struct {
+	// ClientID is the client ID of the federated application or managed identity.
+	// Defaults to the `AZURE_CLIENT_ID` environment variable.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+
+	// ServiceAccountRef is the ServiceAccount a token is requested for.
+	// Its audiences default to `api://AzureADTokenExchange`.
+	// If not set, the projected ServiceAccount token of the reloader pod (`AZURE_FEDERATED_TOKEN_FILE`) is used.
+	// +optional
+	ServiceAccountRef *ServiceAccountSelector `json:"serviceAccountRef,omitempty"`
+}
@@ -0,0 +1,71 @@
+package v1alpha1
+
+// CloudEventsConfig contains configuration for CloudEvents delivered over HTTP.
+// Binary, structured and batch content modes are supported.
+type CloudEventsConfig struct {
+	// Path that the listener will receive the events on.
+	// If not present `/cloudevents` will be used.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Address is the address where the listener will be served in your infrastructure.
+	// If not present, defaults to `:8091`
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Auth is the authentication method for the listener
+	// +optional
+	Auth *WebhookAuth `json:"webhookAuth,omitempty"`
+
+	// RetryPolicy represents the policy to retry when a message fails.
+	// If it's empty, reloader will return a 5xx and won't retry.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// AllowedOrigins is the list of origins accepted on the CloudEvents webhook abuse protection handshake.
+	// If empty, any origin is allowed.
+	// +optional
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+
+	// AllowedRate is the number of requests per minute returned on the abuse protection handshake.
+	// If not set, no rate limit is advertised.
+	// +optional
+	AllowedRate *int32 `json:"allowedRate,omitempty"`
+
+	// Rules map CloudEvents to secret identifiers. The first matching rule is used.
+	// Events that do not match any rule are ignored.
+	// If no rule is given, the event `subject` is used as the secret identifier.
+	// +optional
+	Rules []CloudEventsRule `json:"rules,omitempty"`
+}
+
+// CloudEventsRule matches CloudEvents attributes and defines where the secret identifier is taken from.
+type CloudEventsRule struct {
+	// Type is a regular expression matched against the event `type`. Empty matches any type.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Source is a regular expression matched against the event `source`. Empty matches any source.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// Subject is a regular expression matched against the event `subject`. Empty matches any subject.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// IdentifierFrom is the event attribute holding the secret identifier.
+	// When set to `data`, IdentifierPathOnData is used to find the identifier in the event data.
+	// +kubebuilder:validation:Enum=subject;source;type;id;data
+	// +kubebuilder:default=subject
+	// +optional
+	IdentifierFrom string `json:"identifierFrom,omitempty"`
+
+	// IdentifierPathOnData is a gjson path to the secret identifier in the event data.
+	// Required if IdentifierFrom is `data`.
+	// +optional
+	IdentifierPathOnData string `json:"identifierPathOnData,omitempty"`
+
+	// NamespacePathOnData is an optional gjson path to the secret namespace in the event data.
+	// +optional
+	NamespacePathOnData string `json:"namespacePathOnData,omitempty"`
+}
@@ -0,0 +1,30 @@
+package v1alpha1
+
+// GoogleSecretManagerPollConfig contains configuration for polling GCP Secret Manager for new secret versions.
+// A secret is reported as changed when its latest enabled version differs from the known one.
+type GoogleSecretManagerPollConfig struct {
+	// ProjectID is the GCP project ID where the secrets exist.
+	// +required
+	ProjectID string `json:"projectID"`
+
+	// Authentication methods for GCP Secret Manager.
+	// +optional
+	Auth *GooglePubSubAuth `json:"auth,omitempty"`
+
+	// NamePrefix limits polling to secrets whose name starts with the given prefix.
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// Labels limits polling to secrets having all the given labels.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Filter is an additional Secret Manager list filter, e.g. `labels.env:prod*`.
+	// It is combined with Labels using AND.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+
+	// Poll configures the poll interval, concurrency and where known versions are stored.
+	// +optional
+	Poll *PollConfig `json:"poll,omitempty"`
+}
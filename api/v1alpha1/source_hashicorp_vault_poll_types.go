@@ -0,0 +1,70 @@
+package v1alpha1
+
+// HashicorpVaultPollConfig contains configuration for polling the metadata of HashiCorp Vault KV v2 secrets.
+// A secret is reported as changed when its `current_version` or `updated_time` differs from the known one.
+// Only the `metadata/` endpoints are read, so the Vault policy needs `list` and `read` on metadata only.
+type HashicorpVaultPollConfig struct {
+	// Address is the address of the Vault server, e.g. `https://vault.example.com:8200`.
+	// +required
+	Address string `json:"address"`
+
+	// Namespace is the Vault Enterprise namespace to use.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// MountPath is the mount path of the KV v2 secrets engine. Defaults to `secret`.
+	// +kubebuilder:default=secret
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Paths are the secret paths to watch, relative to MountPath.
+	// Paths ending with `/` are folders whose secrets are listed; other paths are single secrets.
+	// An empty path watches the root of the mount.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Paths []string `json:"paths"`
+
+	// Recursive lists sub folders of the watched folders as well.
+	// +optional
+	Recursive bool `json:"recursive,omitempty"`
+
+	// Auth is the authentication method used to get a Vault token.
+	// +required
+	Auth HashicorpVaultPollAuth `json:"auth"`
+
+	// TLS configures the connection to the Vault server.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// Poll configures the poll interval, concurrency and where known versions are stored.
+	// +optional
+	Poll *PollConfig `json:"poll,omitempty"`
+}
+
+// HashicorpVaultPollAuth contains the authentication methods for Vault. Exactly one must be set.
+type HashicorpVaultPollAuth struct {
+	// Kubernetes authenticates with the Vault Kubernetes auth method using a ServiceAccount token.
+	// +optional
+	Kubernetes *HashicorpVaultKubernetesAuth `json:"kubernetes,omitempty"`
+
+	// TokenRef references a static Vault token.
+	// +optional
+	TokenRef *SecretKeySelector `json:"tokenSecretRef,omitempty"`
+}
+
+// HashicorpVaultKubernetesAuth configures the Vault Kubernetes auth method.
+type HashicorpVaultKubernetesAuth struct {
+	// MountPath is the mount path of the Kubernetes auth method. Defaults to `kubernetes`.
+	// +kubebuilder:default=kubernetes
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Role is the Vault role to log in with.
+	// +required
+	Role string `json:"role"`
+
+	// ServiceAccountRef is the ServiceAccount a token is requested for.
+	// The token audiences must match the ones accepted by the Vault role.
+	// +required
+	ServiceAccountRef ServiceAccountSelector `json:"serviceAccountRef"`
+}
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KafkaConfig contains configuration for consuming secret change events from Kafka topics.
+// Offsets are committed only after the event has been handed off to the reloader.
+type KafkaConfig struct {
+	// Brokers is the list of seed brokers (host:port) to connect to.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Brokers []string `json:"brokers"`
+
+	// Topics is the list of topics to consume from.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Topics []string `json:"topics"`
+
+	// ConsumerGroup is the consumer group used to track committed offsets.
+	// +required
+	ConsumerGroup string `json:"consumerGroup"`
+
+	// StartOffset is where to start consuming when the group has no committed offset.
+	// +kubebuilder:validation:Enum=earliest;latest
+	// +kubebuilder:default=latest
+	// +optional
+	StartOffset string `json:"startOffset,omitempty"`
+
+	// SASL configures SASL authentication against the brokers.
+	// +optional
+	SASL *KafkaSASL `json:"sasl,omitempty"`
+
+	// TLS configures TLS for the broker connections.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// SecretIdentifierOnPayload is the gjson path to the secret identifier in the record value.
+	// It accepts gjson queries returning arrays (e.g. `records.#.name`), in which case one event is emitted per element.
+	// +required
+	SecretIdentifierOnPayload string `json:"identifierPathOnPayload"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the record value.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the record value.
+	// If not set or not found, the time the record was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the record value.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+
+	// PauseAfter is how long handing off an event to the reloader may block before fetching is paused.
+	// Fetching resumes as soon as the event is delivered. If not set, fetching is never paused.
+	// +optional
+	PauseAfter *metav1.Duration `json:"pauseAfter,omitempty"`
+}
+
+// KafkaSASL contains SASL authentication settings for Kafka.
+type KafkaSASL struct {
+	// Mechanism is the SASL mechanism to use.
+	// +kubebuilder:validation:Enum=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	// +kubebuilder:default=SCRAM-SHA-512
+	// +optional
+	Mechanism string `json:"mechanism,omitempty"`
+
+	// UsernameRef references the SASL username.
+	// +required
+	UsernameRef SecretKeySelector `json:"usernameSecretRef"`
+
+	// PasswordRef references the SASL password.
+	// +required
+	PasswordRef SecretKeySelector `json:"passwordSecretRef"`
+}
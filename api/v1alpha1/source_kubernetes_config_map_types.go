@@ -2,7 +2,7 @@ package v1alpha1
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-// KubernetesConfigMapConfig contains configuration for Kubernetes notifications.
+// KubernetesConfigMapConfig contains configuration for Kubernetes ConfigMap notifications.
 type KubernetesConfigMapConfig struct {
 	// Server URL
 	// +required
@@ -12,6 +12,6 @@ type KubernetesConfigMapConfig struct {
 	// +optional
 	Auth *KubernetesAuth `json:"auth,omitempty"`
 
-	// LabelSelector can be used to identify and narrow down secrets for watching.
+	// LabelSelector can be used to identify and narrow down configmaps for watching.
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
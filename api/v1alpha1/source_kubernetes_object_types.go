@@ -0,0 +1,41 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// KubernetesObjectWatchConfig contains configuration for watching Kubernetes objects of any kind,
+// e.g. cert-manager Certificates or Crossplane managed resources.
+// The reloader ServiceAccount, or the configured Auth, needs get, list and watch permissions on the watched resource.
+type KubernetesObjectWatchConfig struct {
+	// Server URL
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// How to authenticate with Kubernetes cluster. If not specified, the default config is used.
+	// +optional
+	Auth *KubernetesAuth `json:"auth,omitempty"`
+
+	// Group of the watched objects. Empty for the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version of the watched objects.
+	// +required
+	Version string `json:"version"`
+
+	// Kind of the watched objects.
+	// +required
+	Kind string `json:"kind"`
+
+	// Namespace limits the watch to a single namespace. If empty, all namespaces are watched.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector can be used to identify and narrow down objects for watching.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// FieldPath is the path of the field whose changes trigger events, e.g. `.data` or `.status.certificate`.
+	// If empty, any change to the object triggers an event.
+	// +optional
+	FieldPath string `json:"fieldPath,omitempty"`
+}
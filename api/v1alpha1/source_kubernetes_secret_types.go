@@ -14,4 +14,20 @@ type KubernetesSecretConfig struct {
 
 	// LabelSelector can be used to identify and narrow down secrets for watching.
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Keys limits change detection to the given keys of the secret data.
+	// If empty, events are fired when any key of `.data` or `.stringData` changes.
+	// Label, annotation and other metadata changes never fire events.
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+
+	// StateConfigMapRef references the ConfigMap key where the hashes of the last seen secret data are stored.
+	// The ConfigMap is created if it does not exist. With a stored state, secrets rotated while the reloader
+	// was down or starting fire an event once they are listed again.
+	// If not set, the hashes are only kept in memory: after a restart, every secret is seen for the first time
+	// and only recorded, so a rotation that happens while the reloader is down or starting fires no event.
+	// Set this field to not miss those rotations.
+	// Large states are split across ConfigMaps named after it with a `-1`, `-2`... suffix.
+	// +optional
+	StateConfigMapRef *ConfigMapKeySelector `json:"stateConfigMapRef,omitempty"`
 }
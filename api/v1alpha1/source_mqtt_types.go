@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MqttConfig contains configuration for consuming secret change events from an MQTT broker.
+type MqttConfig struct {
+	// Brokers is the list of broker URLs, e.g. `tcp://mosquitto:1883` or `ssl://mosquitto:8883`.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Brokers []string `json:"brokers"`
+
+	// ClientID identifies the reloader to the broker. It must be stable for a persistent session to be resumed.
+	// +required
+	ClientID string `json:"clientID"`
+
+	// CleanSession discards the session state on connect. By default a persistent session is used,
+	// so QoS 1 and 2 messages published while the reloader is disconnected are delivered on reconnect.
+	// +optional
+	CleanSession bool `json:"cleanSession,omitempty"`
+
+	// Topics is the list of topic filters to subscribe to.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Topics []MqttTopic `json:"topics"`
+
+	// UsernameRef references the username used to authenticate.
+	// +optional
+	UsernameRef *SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordRef references the password used to authenticate.
+	// +optional
+	PasswordRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// TLS configures TLS for the broker connection. Client certificate authentication uses its certificate and key refs.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// MaxReconnectInterval caps the exponential backoff between reconnection attempts. Defaults to 2m.
+	// +optional
+	MaxReconnectInterval *metav1.Duration `json:"maxReconnectInterval,omitempty"`
+
+	// IdentifierTopicSegment is the zero based index of the topic level holding the secret identifier,
+	// e.g. 2 for `factory/line-1/db-password`. If set, SecretIdentifierOnPayload is ignored.
+	// +optional
+	IdentifierTopicSegment *int32 `json:"identifierTopicSegment,omitempty"`
+
+	// NamespaceTopicSegment is the zero based index of the topic level holding the secret namespace.
+	// +optional
+	NamespaceTopicSegment *int32 `json:"namespaceTopicSegment,omitempty"`
+
+	// SecretIdentifierOnPayload is the gjson path to the secret identifier in the message payload.
+	// Required if IdentifierTopicSegment is not set.
+	// +optional
+	SecretIdentifierOnPayload string `json:"identifierPathOnPayload,omitempty"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the message payload.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the message payload.
+	// If not set or not found, the time the message was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the message payload.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+}
+
+// MqttTopic is a topic filter subscription.
+type MqttTopic struct {
+	// Filter is the topic filter. Wildcards `+` and `#` are allowed.
+	// +required
+	Filter string `json:"filter"`
+
+	// QoS is the maximum quality of service of the subscription.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +kubebuilder:default=1
+	// +optional
+	QoS int32 `json:"qos,omitempty"`
+}
@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NatsConfig contains configuration for consuming secret change events from NATS.
+// Either a core NATS subject or a JetStream durable consumer can be used.
+type NatsConfig struct {
+	// Servers is the list of NATS server URLs to connect to.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Servers []string `json:"servers"`
+
+	// Subject is the subject to subscribe to. Wildcards are allowed.
+	// With JetStream, it is used as the consumer filter subject.
+	// +required
+	Subject string `json:"subject"`
+
+	// QueueGroup is an optional queue group for core NATS subscriptions,
+	// so that only one reloader replica receives each message.
+	// +optional
+	QueueGroup string `json:"queueGroup,omitempty"`
+
+	// JetStream configures a durable JetStream consumer. Messages are acknowledged
+	// only after their events were handed off to the reloader.
+	// +optional
+	JetStream *NatsJetStream `json:"jetStream,omitempty"`
+
+	// Auth is the authentication method for the NATS connection.
+	// +optional
+	Auth *NatsAuth `json:"auth,omitempty"`
+
+	// TLS configures TLS for the NATS connection.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// SecretIdentifierOnPayload is the gjson path to the secret identifier in the message.
+	// It accepts gjson queries returning arrays (e.g. `records.#.name`), in which case one event is emitted per element.
+	// +required
+	SecretIdentifierOnPayload string `json:"identifierPathOnPayload"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the message.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the message.
+	// If not set or not found, the time the message was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the message.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+}
+
+// NatsJetStream contains configuration for a durable JetStream consumer.
+type NatsJetStream struct {
+	// Stream is the name of the stream to consume from.
+	// +required
+	Stream string `json:"stream"`
+
+	// Durable is the name of the durable consumer. It is created if it does not exist.
+	// +required
+	Durable string `json:"durable"`
+
+	// DeliverPolicy is where a newly created consumer starts delivering messages from.
+	// +kubebuilder:validation:Enum=all;new;last
+	// +kubebuilder:default=new
+	// +optional
+	DeliverPolicy string `json:"deliverPolicy,omitempty"`
+
+	// AckWait is how long the server waits for an acknowledgement before redelivering a message.
+	// +optional
+	AckWait *metav1.Duration `json:"ackWait,omitempty"`
+
+	// MaxDeliver is the maximum number of delivery attempts of a message.
+	// If not set, messages are redelivered until acknowledged.
+	// +optional
+	MaxDeliver *int32 `json:"maxDeliver,omitempty"`
+}
+
+// NatsAuth contains authentication methods for NATS. Only one method may be set.
+type NatsAuth struct {
+	// NKeySeedRef references an NKey user seed.
+	// +optional
+	NKeySeedRef *SecretKeySelector `json:"nkeySeedSecretRef,omitempty"`
+
+	// CredentialsRef references a credentials file holding a user JWT and its NKey seed.
+	// +optional
+	CredentialsRef *SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+
+	// TokenRef references an authentication token.
+	// +optional
+	TokenRef *SecretKeySelector `json:"tokenSecretRef,omitempty"`
+}
@@ -0,0 +1,111 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedisConfig contains configuration for consuming secret change events from Redis.
+// Either Pub/Sub channels or a Stream consumed through a consumer group can be used.
+type RedisConfig struct {
+	// Address is the host:port of a standalone Redis server. Required if Sentinel is not set.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Sentinel configures a connection through Redis Sentinel.
+	// +optional
+	Sentinel *RedisSentinel `json:"sentinel,omitempty"`
+
+	// DB is the database to select after connecting.
+	// +optional
+	DB int32 `json:"db,omitempty"`
+
+	// UsernameRef references the ACL username used to authenticate.
+	// +optional
+	UsernameRef *SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordRef references the password used to authenticate.
+	// +optional
+	PasswordRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// TLS configures TLS for the Redis connection.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// Channels is the list of Pub/Sub channels to subscribe to.
+	// Pub/Sub does not persist messages, so events published while the reloader is down are lost.
+	// +optional
+	Channels []string `json:"channels,omitempty"`
+
+	// Stream configures consuming a Redis Stream through a consumer group.
+	// Entries are acknowledged only after their events were handed off to the reloader.
+	// +optional
+	Stream *RedisStream `json:"stream,omitempty"`
+
+	// SecretIdentifierOnPayload is the gjson path to the secret identifier in the message.
+	// For streams, the payload is the PayloadField value or, if not set, a JSON object built from the entry fields.
+	// +required
+	SecretIdentifierOnPayload string `json:"identifierPathOnPayload"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the message.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the message.
+	// If not set or not found, the time the message was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the message.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+}
+
+// RedisSentinel contains configuration to discover the Redis master through Sentinel.
+type RedisSentinel struct {
+	// MasterName is the name of the master monitored by the sentinels.
+	// +required
+	MasterName string `json:"masterName"`
+
+	// Addresses is the list of sentinel host:port addresses.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Addresses []string `json:"addresses"`
+
+	// PasswordRef references the password used to authenticate against the sentinels.
+	// +optional
+	PasswordRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// RedisStream contains configuration for consuming a Redis Stream with a consumer group.
+type RedisStream struct {
+	// Key is the key of the stream.
+	// +required
+	Key string `json:"key"`
+
+	// ConsumerGroup is the consumer group to read with. It is created if it does not exist.
+	// +required
+	ConsumerGroup string `json:"consumerGroup"`
+
+	// Consumer is the consumer name within the group. Defaults to the pod hostname.
+	// A stable name lets a restarted reloader resume its own pending entries.
+	// +optional
+	Consumer string `json:"consumer,omitempty"`
+
+	// StartID is the stream ID a newly created consumer group starts from.
+	// Use `$` for new entries only or `0` for the whole stream.
+	// +kubebuilder:default="$"
+	// +optional
+	StartID string `json:"startID,omitempty"`
+
+	// PayloadField is the entry field holding the JSON payload.
+	// If not set, the entry fields are used as a JSON object.
+	// +optional
+	PayloadField string `json:"payloadField,omitempty"`
+
+	// ReclaimMinIdle is how long an entry must be pending on another consumer before it is
+	// reclaimed on start. Defaults to 1m.
+	// +optional
+	ReclaimMinIdle *metav1.Duration `json:"reclaimMinIdle,omitempty"`
+}
@@ -12,6 +12,22 @@ type TCPSocketConfig struct {
 	Port int32 `json:"port"`
 
 	// SecretIdentifierOnPayload is the key that the reloader will look for in the payload.
-	// The value of this key should be the same name as in the external secret. It will default to `0.data.ObjectName` if not set
+	// The value of this key should be the same name as in the external secret. It will default to `0.data.ObjectName` if not set.
+	// It accepts gjson queries returning arrays (e.g. `records.#.name`), in which case one event is emitted per element.
 	SecretIdentifierOnPayload string `json:"identifierPathOnPayload,omitempty"`
+
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the payload.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the payload.
+	// If not set or not found, the time the message was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the payload.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
 }
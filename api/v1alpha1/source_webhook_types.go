@@ -13,10 +13,26 @@ type WebhookConfig struct {
 	Address string `json:"address"`
 
 	// SecretIdentifierOnPayload is the key that the reloader will look for in the payload.
-	// The value of this key should be the same name as in the external secret. It will default to `0.data.ObjectName` if not set
+	// The value of this key should be the same name as in the external secret. It will default to `0.data.ObjectName` if not set.
+	// It accepts gjson queries returning arrays (e.g. `records.#.name`), in which case one event is emitted per element.
 	// +optional
 	SecretIdentifierOnPayload string `json:"identifierPathOnPayload,omitempty"`
 
+	// NamespacePathOnPayload is an optional path to the namespace of the secret in the payload.
+	// If it returns an array, it must have the same size as the identifiers array.
+	// +optional
+	NamespacePathOnPayload string `json:"namespacePathOnPayload,omitempty"`
+
+	// TimestampPathOnPayload is an optional path to the rotation timestamp in the payload.
+	// If not set or not found, the time the payload was received is used.
+	// +optional
+	TimestampPathOnPayload string `json:"timestampPathOnPayload,omitempty"`
+
+	// EventTypePathOnPayload is an optional path to the event type in the payload.
+	// If found, it is added to the trigger source of the event.
+	// +optional
+	EventTypePathOnPayload string `json:"eventTypePathOnPayload,omitempty"`
+
 	// Auth is the authentication method for the webhook
 	// +optional
 	Auth *WebhookAuth `json:"webhookAuth,omitempty"`
@@ -47,6 +63,10 @@ type WebhookAuth struct {
 	// BearerToken references a Kubernetes Secret containing the bearer token.
 	// +optional
 	BearerToken *BearerToken `json:"bearerToken,omitempty"`
+
+	// OIDC validates signed JWT/OIDC ID tokens sent as `Authorization: Bearer <jwt>`.
+	// +optional
+	OIDC *OIDCAuth `json:"oidc,omitempty"`
 }
 
 // BasicAuth contains basic authentication credentials.
@@ -66,3 +86,63 @@ type BearerToken struct {
 	// +required
 	BearerTokenSecretRef SecretKeySelector `json:"bearerTokenSecretRef"`
 }
+
+// OIDCAuth contains the configuration to validate JWT/OIDC ID tokens.
+type OIDCAuth struct {
+	// IssuerURL is the expected value of the `iss` claim.
+	// +required
+	IssuerURL string `json:"issuerURL"`
+
+	// Audiences is the list of accepted `aud` claim values. The token must contain at least one of them.
+	// If empty, the audience is not checked.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// RequiredClaims is a map of claims that must be present in the token with the given values.
+	// For array claims, the value must be one of the elements.
+	// +optional
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+
+	// JWKS is the source of the JSON Web Key Set used to validate token signatures.
+	// +required
+	JWKS JWKSSource `json:"jwks"`
+
+	// IdentifierRestrictions limits which secret identifiers a caller may trigger based on its token claims.
+	// If set, a request is only processed when at least one restriction matches the token claims
+	// and allows the secret identifier found on the payload.
+	// Requests authenticated with BasicAuth or BearerToken carry no claims, so they are rejected when this is set.
+	// +optional
+	IdentifierRestrictions []OIDCIdentifierRestriction `json:"identifierRestrictions,omitempty"`
+}
+
+// JWKSSource defines where to fetch the JSON Web Key Set from. Exactly one of the fields must be set.
+type JWKSSource struct {
+	// URL is the HTTPS endpoint serving the JWKS, e.g. `https://issuer/.well-known/jwks.json`.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// SecretRef references a Kubernetes Secret key containing the JWKS document.
+	// +optional
+	SecretRef *SecretKeySelector `json:"secretRef,omitempty"`
+
+	// ConfigMapRef references a Kubernetes ConfigMap key containing the JWKS document.
+	// Useful for air-gapped setups where the issuer is not reachable.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// OIDCIdentifierRestriction allows callers whose claim matches one of Values to trigger
+// the secret identifiers matching AllowedIdentifiers.
+type OIDCIdentifierRestriction struct {
+	// Claim is the name of the token claim to match, e.g. `sub`.
+	// +required
+	Claim string `json:"claim"`
+
+	// Values are the accepted claim values. For array claims, any element may match.
+	// +required
+	Values []string `json:"values"`
+
+	// AllowedIdentifiers is a list of regular expressions the secret identifier must match.
+	// +required
+	AllowedIdentifiers []string `json:"allowedIdentifiers"`
+}
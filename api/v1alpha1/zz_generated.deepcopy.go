@@ -83,6 +83,143 @@ func (in *AWSSQSConfig) DeepCopy() *AWSSQSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretsManagerPollConfig) DeepCopyInto(out *AWSSecretsManagerPollConfig) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(PollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretsManagerPollConfig.
+func (in *AWSSecretsManagerPollConfig) DeepCopy() *AWSSecretsManagerPollConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretsManagerPollConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AmqpConfig) DeepCopyInto(out *AmqpConfig) {
+	*out = *in
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exchange != nil {
+		in, out := &in.Exchange, &out.Exchange
+		*out = new(AmqpExchange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconnectDelay != nil {
+		in, out := &in.ReconnectDelay, &out.ReconnectDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AmqpConfig.
+func (in *AmqpConfig) DeepCopy() *AmqpConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AmqpConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AmqpExchange) DeepCopyInto(out *AmqpExchange) {
+	*out = *in
+	if in.RoutingKeys != nil {
+		in, out := &in.RoutingKeys, &out.RoutingKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AmqpExchange.
+func (in *AmqpExchange) DeepCopy() *AmqpExchange {
+	if in == nil {
+		return nil
+	}
+	out := new(AmqpExchange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDApplicationDestination) DeepCopyInto(out *ArgoCDApplicationDestination) {
+	*out = *in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDApplicationDestination.
+func (in *ArgoCDApplicationDestination) DeepCopy() *ArgoCDApplicationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDApplicationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureClientSecretAuth) DeepCopyInto(out *AzureClientSecretAuth) {
+	*out = *in
+	out.ClientIDRef = in.ClientIDRef
+	out.ClientSecretRef = in.ClientSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClientSecretAuth.
+func (in *AzureClientSecretAuth) DeepCopy() *AzureClientSecretAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureClientSecretAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventGridConfig) DeepCopyInto(out *AzureEventGridConfig) {
 	*out = *in
@@ -103,6 +240,84 @@ func (in *AzureEventGridConfig) DeepCopy() *AzureEventGridConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureKeyVaultAuth) DeepCopyInto(out *AzureKeyVaultAuth) {
+	*out = *in
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(AzureClientSecretAuth)
+		**out = **in
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(AzureWorkloadIdentityAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureKeyVaultAuth.
+func (in *AzureKeyVaultAuth) DeepCopy() *AzureKeyVaultAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureKeyVaultAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureKeyVaultPollConfig) DeepCopyInto(out *AzureKeyVaultPollConfig) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(PollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureKeyVaultPollConfig.
+func (in *AzureKeyVaultPollConfig) DeepCopy() *AzureKeyVaultPollConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureKeyVaultPollConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureWorkloadIdentityAuth) DeepCopyInto(out *AzureWorkloadIdentityAuth) {
+	*out = *in
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(ServiceAccountSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureWorkloadIdentityAuth.
+func (in *AzureWorkloadIdentityAuth) DeepCopy() *AzureWorkloadIdentityAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureWorkloadIdentityAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -136,6 +351,102 @@ func (in *BearerToken) DeepCopy() *BearerToken {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsConfig) DeepCopyInto(out *CloudEventsConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(WebhookAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		**out = **in
+	}
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRate != nil {
+		in, out := &in.AllowedRate, &out.AllowedRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]CloudEventsRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsConfig.
+func (in *CloudEventsConfig) DeepCopy() *CloudEventsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsRule) DeepCopyInto(out *CloudEventsRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsRule.
+func (in *CloudEventsRule) DeepCopy() *CloudEventsRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExternalSecretDestination) DeepCopyInto(out *ClusterExternalSecretDestination) {
+	*out = *in
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExternalSecretDestination.
+func (in *ClusterExternalSecretDestination) DeepCopy() *ClusterExternalSecretDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExternalSecretDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -210,6 +521,21 @@ func (in *ConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigSpec) DeepCopyInto(out *ConfigSpec) {
 	*out = *in
@@ -249,6 +575,13 @@ func (in *ConfigStatus) DeepCopyInto(out *ConfigStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigStatus.
@@ -262,7 +595,7 @@ func (in *ConfigStatus) DeepCopy() *ConfigStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentDestination) DeepCopyInto(out *DeploymentDestination) {
+func (in *CronJobDestination) DeepCopyInto(out *CronJobDestination) {
 	*out = *in
 	if in.NamespaceSelectors != nil {
 		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
@@ -283,31 +616,83 @@ func (in *DeploymentDestination) DeepCopyInto(out *DeploymentDestination) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentDestination.
-func (in *DeploymentDestination) DeepCopy() *DeploymentDestination {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobDestination.
+func (in *CronJobDestination) DeepCopy() *CronJobDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentDestination)
+	out := new(CronJobDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DestinationToWatch) DeepCopyInto(out *DestinationToWatch) {
+func (in *DeploymentDestination) DeepCopyInto(out *DeploymentDestination) {
 	*out = *in
-	if in.WorkflowRunTemplate != nil {
-		in, out := &in.WorkflowRunTemplate, &out.WorkflowRunTemplate
-		*out = new(WorkflowRunTemplateDestination)
-		(*in).DeepCopyInto(*out)
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ExternalSecret != nil {
-		in, out := &in.ExternalSecret, &out.ExternalSecret
-		*out = new(ExternalSecretDestination)
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PushSecret != nil {
-		in, out := &in.PushSecret, &out.PushSecret
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentDestination.
+func (in *DeploymentDestination) DeepCopy() *DeploymentDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationCluster) DeepCopyInto(out *DestinationCluster) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationCluster.
+func (in *DestinationCluster) DeepCopy() *DestinationCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationToWatch) DeepCopyInto(out *DestinationToWatch) {
+	*out = *in
+	if in.WorkflowRunTemplate != nil {
+		in, out := &in.WorkflowRunTemplate, &out.WorkflowRunTemplate
+		*out = new(WorkflowRunTemplateDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalSecret != nil {
+		in, out := &in.ExternalSecret, &out.ExternalSecret
+		*out = new(ExternalSecretDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PushSecret != nil {
+		in, out := &in.PushSecret, &out.PushSecret
 		*out = new(PushSecretDestination)
 		(*in).DeepCopyInto(*out)
 	}
@@ -316,6 +701,56 @@ func (in *DestinationToWatch) DeepCopyInto(out *DestinationToWatch) {
 		*out = new(DeploymentDestination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CronJob != nil {
+		in, out := &in.CronJob, &out.CronJob
+		*out = new(CronJobDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flux != nil {
+		in, out := &in.Flux, &out.Flux
+		*out = new(FluxDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArgoCDApplication != nil {
+		in, out := &in.ArgoCDApplication, &out.ArgoCDApplication
+		*out = new(ArgoCDApplicationDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HttpCallback != nil {
+		in, out := &in.HttpCallback, &out.HttpCallback
+		*out = new(HttpCallbackDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(JobTemplateDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(PodDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Generic != nil {
+		in, out := &in.Generic, &out.Generic
+		*out = new(GenericDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterExternalSecret != nil {
+		in, out := &in.ClusterExternalSecret, &out.ClusterExternalSecret
+		*out = new(ClusterExternalSecretDestination)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UpdateStrategy != nil {
 		in, out := &in.UpdateStrategy, &out.UpdateStrategy
 		*out = new(UpdateStrategy)
@@ -331,6 +766,11 @@ func (in *DestinationToWatch) DeepCopyInto(out *DestinationToWatch) {
 		*out = new(WaitStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(DestinationCluster)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationToWatch.
@@ -375,6 +815,38 @@ func (in *ExternalSecretDestination) DeepCopy() *ExternalSecretDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxDestination) DeepCopyInto(out *FluxDestination) {
+	*out = *in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxDestination.
+func (in *FluxDestination) DeepCopy() *FluxDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPSMAuthSecretRef) DeepCopyInto(out *GCPSMAuthSecretRef) {
 	*out = *in
@@ -407,6 +879,38 @@ func (in *GCPWorkloadIdentity) DeepCopy() *GCPWorkloadIdentity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericDestination) DeepCopyInto(out *GenericDestination) {
+	*out = *in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericDestination.
+func (in *GenericDestination) DeepCopy() *GenericDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GooglePubSubAuth) DeepCopyInto(out *GooglePubSubAuth) {
 	*out = *in
@@ -427,279 +931,1165 @@ func (in *GooglePubSubAuth) DeepCopy() *GooglePubSubAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(GooglePubSubAuth)
+	out := new(GooglePubSubAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GooglePubSubConfig) DeepCopyInto(out *GooglePubSubConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(GooglePubSubAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GooglePubSubConfig.
+func (in *GooglePubSubConfig) DeepCopy() *GooglePubSubConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GooglePubSubConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleSecretManagerPollConfig) DeepCopyInto(out *GoogleSecretManagerPollConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(GooglePubSubAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(PollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleSecretManagerPollConfig.
+func (in *GoogleSecretManagerPollConfig) DeepCopy() *GoogleSecretManagerPollConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleSecretManagerPollConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashicorpVaultConfig) DeepCopyInto(out *HashicorpVaultConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashicorpVaultConfig.
+func (in *HashicorpVaultConfig) DeepCopy() *HashicorpVaultConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HashicorpVaultConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashicorpVaultKubernetesAuth) DeepCopyInto(out *HashicorpVaultKubernetesAuth) {
+	*out = *in
+	in.ServiceAccountRef.DeepCopyInto(&out.ServiceAccountRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashicorpVaultKubernetesAuth.
+func (in *HashicorpVaultKubernetesAuth) DeepCopy() *HashicorpVaultKubernetesAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HashicorpVaultKubernetesAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashicorpVaultPollAuth) DeepCopyInto(out *HashicorpVaultPollAuth) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(HashicorpVaultKubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashicorpVaultPollAuth.
+func (in *HashicorpVaultPollAuth) DeepCopy() *HashicorpVaultPollAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HashicorpVaultPollAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashicorpVaultPollConfig) DeepCopyInto(out *HashicorpVaultPollConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(PollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashicorpVaultPollConfig.
+func (in *HashicorpVaultPollConfig) DeepCopy() *HashicorpVaultPollConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HashicorpVaultPollConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpCallbackDestination) DeepCopyInto(out *HttpCallbackDestination) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HttpCallbackHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HttpCallbackRetry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpCallbackDestination.
+func (in *HttpCallbackDestination) DeepCopy() *HttpCallbackDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpCallbackDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpCallbackHeader) DeepCopyInto(out *HttpCallbackHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpCallbackHeader.
+func (in *HttpCallbackHeader) DeepCopy() *HttpCallbackHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpCallbackHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpCallbackRetry) DeepCopyInto(out *HttpCallbackRetry) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpCallbackRetry.
+func (in *HttpCallbackRetry) DeepCopy() *HttpCallbackRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpCallbackRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWKSSource) DeepCopyInto(out *JWKSSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWKSSource.
+func (in *JWKSSource) DeepCopy() *JWKSSource {
+	if in == nil {
+		return nil
+	}
+	out := new(JWKSSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobDestination) DeepCopyInto(out *JobDestination) {
+	*out = *in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobDestination.
+func (in *JobDestination) DeepCopy() *JobDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(JobDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplate) DeepCopyInto(out *JobTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplate.
+func (in *JobTemplate) DeepCopy() *JobTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplateDestination) DeepCopyInto(out *JobTemplateDestination) {
+	*out = *in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(JobTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplateDestination.
+func (in *JobTemplateDestination) DeepCopy() *JobTemplateDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplateDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASL)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PauseAfter != nil {
+		in, out := &in.PauseAfter, &out.PauseAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfig.
+func (in *KafkaConfig) DeepCopy() *KafkaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASL) DeepCopyInto(out *KafkaSASL) {
+	*out = *in
+	out.UsernameRef = in.UsernameRef
+	out.PasswordRef = in.PasswordRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASL.
+func (in *KafkaSASL) DeepCopy() *KafkaSASL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeConfigRef) DeepCopyInto(out *KubeConfigRef) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeConfigRef.
+func (in *KubeConfigRef) DeepCopy() *KubeConfigRef {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeConfigRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuth) DeepCopyInto(out *KubernetesAuth) {
+	*out = *in
+	if in.KubeConfigRef != nil {
+		in, out := &in.KubeConfigRef, &out.KubeConfigRef
+		*out = new(KubeConfigRef)
+		**out = **in
+	}
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(TokenRef)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(ServiceAccountSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuth.
+func (in *KubernetesAuth) DeepCopy() *KubernetesAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesConfigMapConfig) DeepCopyInto(out *KubernetesConfigMapConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesConfigMapConfig.
+func (in *KubernetesConfigMapConfig) DeepCopy() *KubernetesConfigMapConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesConfigMapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesObjectConfig) DeepCopyInto(out *KubernetesObjectConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateConfigMapRef != nil {
+		in, out := &in.StateConfigMapRef, &out.StateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesObjectConfig.
+func (in *KubernetesObjectConfig) DeepCopy() *KubernetesObjectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesObjectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesObjectWatchConfig) DeepCopyInto(out *KubernetesObjectWatchConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesObjectWatchConfig.
+func (in *KubernetesObjectWatchConfig) DeepCopy() *KubernetesObjectWatchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesObjectWatchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSecretConfig) DeepCopyInto(out *KubernetesSecretConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateConfigMapRef != nil {
+		in, out := &in.StateConfigMapRef, &out.StateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretConfig.
+func (in *KubernetesSecretConfig) DeepCopy() *KubernetesSecretConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSecretConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchStrategy) DeepCopyInto(out *MatchStrategy) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchStrategy.
+func (in *MatchStrategy) DeepCopy() *MatchStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MockConfig) DeepCopyInto(out *MockConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MockConfig.
+func (in *MockConfig) DeepCopy() *MockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MqttConfig) DeepCopyInto(out *MqttConfig) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]MqttTopic, len(*in))
+		copy(*out, *in)
+	}
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxReconnectInterval != nil {
+		in, out := &in.MaxReconnectInterval, &out.MaxReconnectInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IdentifierTopicSegment != nil {
+		in, out := &in.IdentifierTopicSegment, &out.IdentifierTopicSegment
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NamespaceTopicSegment != nil {
+		in, out := &in.NamespaceTopicSegment, &out.NamespaceTopicSegment
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MqttConfig.
+func (in *MqttConfig) DeepCopy() *MqttConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MqttConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MqttTopic) DeepCopyInto(out *MqttTopic) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MqttTopic.
+func (in *MqttTopic) DeepCopy() *MqttTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(MqttTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsAuth) DeepCopyInto(out *NatsAuth) {
+	*out = *in
+	if in.NKeySeedRef != nil {
+		in, out := &in.NKeySeedRef, &out.NKeySeedRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsAuth.
+func (in *NatsAuth) DeepCopy() *NatsAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsConfig) DeepCopyInto(out *NatsConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(NatsJetStream)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NatsAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsConfig.
+func (in *NatsConfig) DeepCopy() *NatsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsJetStream) DeepCopyInto(out *NatsJetStream) {
+	*out = *in
+	if in.AckWait != nil {
+		in, out := &in.AckWait, &out.AckWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxDeliver != nil {
+		in, out := &in.MaxDeliver, &out.MaxDeliver
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsJetStream.
+func (in *NatsJetStream) DeepCopy() *NatsJetStream {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsJetStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSource) DeepCopyInto(out *NotificationSource) {
+	*out = *in
+	if in.AwsSqs != nil {
+		in, out := &in.AwsSqs, &out.AwsSqs
+		*out = new(AWSSQSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureEventGrid != nil {
+		in, out := &in.AzureEventGrid, &out.AzureEventGrid
+		*out = new(AzureEventGridConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GooglePubSub != nil {
+		in, out := &in.GooglePubSub, &out.GooglePubSub
+		*out = new(GooglePubSubConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HashicorpVault != nil {
+		in, out := &in.HashicorpVault, &out.HashicorpVault
+		*out = new(HashicorpVaultConfig)
+		**out = **in
+	}
+	if in.KubernetesSecret != nil {
+		in, out := &in.KubernetesSecret, &out.KubernetesSecret
+		*out = new(KubernetesSecretConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesConfigMap != nil {
+		in, out := &in.KubernetesConfigMap, &out.KubernetesConfigMap
+		*out = new(KubernetesConfigMapConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesObject != nil {
+		in, out := &in.KubernetesObject, &out.KubernetesObject
+		*out = new(KubernetesObjectWatchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(TCPSocketConfig)
+		**out = **in
+	}
+	if in.CloudEvents != nil {
+		in, out := &in.CloudEvents, &out.CloudEvents
+		*out = new(CloudEventsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = new(NatsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Amqp != nil {
+		in, out := &in.Amqp, &out.Amqp
+		*out = new(AmqpConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mqtt != nil {
+		in, out := &in.Mqtt, &out.Mqtt
+		*out = new(MqttConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AwsSecretsManagerPoll != nil {
+		in, out := &in.AwsSecretsManagerPoll, &out.AwsSecretsManagerPoll
+		*out = new(AWSSecretsManagerPollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleSecretManagerPoll != nil {
+		in, out := &in.GoogleSecretManagerPoll, &out.GoogleSecretManagerPoll
+		*out = new(GoogleSecretManagerPollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HashicorpVaultPoll != nil {
+		in, out := &in.HashicorpVaultPoll, &out.HashicorpVaultPoll
+		*out = new(HashicorpVaultPollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureKeyVaultPoll != nil {
+		in, out := &in.AzureKeyVaultPoll, &out.AzureKeyVaultPoll
+		*out = new(AzureKeyVaultPollConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mock != nil {
+		in, out := &in.Mock, &out.Mock
+		*out = new(MockConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSource.
+func (in *NotificationSource) DeepCopy() *NotificationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GooglePubSubConfig) DeepCopyInto(out *GooglePubSubConfig) {
+func (in *OIDCAuth) DeepCopyInto(out *OIDCAuth) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(GooglePubSubAuth)
-		(*in).DeepCopyInto(*out)
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredClaims != nil {
+		in, out := &in.RequiredClaims, &out.RequiredClaims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.JWKS.DeepCopyInto(&out.JWKS)
+	if in.IdentifierRestrictions != nil {
+		in, out := &in.IdentifierRestrictions, &out.IdentifierRestrictions
+		*out = make([]OIDCIdentifierRestriction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GooglePubSubConfig.
-func (in *GooglePubSubConfig) DeepCopy() *GooglePubSubConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCAuth.
+func (in *OIDCAuth) DeepCopy() *OIDCAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(GooglePubSubConfig)
+	out := new(OIDCAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HashicorpVaultConfig) DeepCopyInto(out *HashicorpVaultConfig) {
+func (in *OIDCIdentifierRestriction) DeepCopyInto(out *OIDCIdentifierRestriction) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIdentifiers != nil {
+		in, out := &in.AllowedIdentifiers, &out.AllowedIdentifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashicorpVaultConfig.
-func (in *HashicorpVaultConfig) DeepCopy() *HashicorpVaultConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCIdentifierRestriction.
+func (in *OIDCIdentifierRestriction) DeepCopy() *OIDCIdentifierRestriction {
 	if in == nil {
 		return nil
 	}
-	out := new(HashicorpVaultConfig)
+	out := new(OIDCIdentifierRestriction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeConfigRef) DeepCopyInto(out *KubeConfigRef) {
+func (in *PatchOperationConfig) DeepCopyInto(out *PatchOperationConfig) {
 	*out = *in
-	out.SecretRef = in.SecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeConfigRef.
-func (in *KubeConfigRef) DeepCopy() *KubeConfigRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchOperationConfig.
+func (in *PatchOperationConfig) DeepCopy() *PatchOperationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeConfigRef)
+	out := new(PatchOperationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesAuth) DeepCopyInto(out *KubernetesAuth) {
+func (in *PodDestination) DeepCopyInto(out *PodDestination) {
 	*out = *in
-	if in.KubeConfigRef != nil {
-		in, out := &in.KubeConfigRef, &out.KubeConfigRef
-		*out = new(KubeConfigRef)
-		**out = **in
-	}
-	if in.TokenRef != nil {
-		in, out := &in.TokenRef, &out.TokenRef
-		*out = new(TokenRef)
-		**out = **in
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ServiceAccountRef != nil {
-		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
-		*out = new(ServiceAccountSelector)
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuth.
-func (in *KubernetesAuth) DeepCopy() *KubernetesAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDestination.
+func (in *PodDestination) DeepCopy() *PodDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesAuth)
+	out := new(PodDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesConfigMapConfig) DeepCopyInto(out *KubernetesConfigMapConfig) {
+func (in *PollConfig) DeepCopyInto(out *PollConfig) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(KubernetesAuth)
-		(*in).DeepCopyInto(*out)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.LabelSelector != nil {
-		in, out := &in.LabelSelector, &out.LabelSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StateConfigMapRef != nil {
+		in, out := &in.StateConfigMapRef, &out.StateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesConfigMapConfig.
-func (in *KubernetesConfigMapConfig) DeepCopy() *KubernetesConfigMapConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollConfig.
+func (in *PollConfig) DeepCopy() *PollConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesConfigMapConfig)
+	out := new(PollConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesObjectConfig) DeepCopyInto(out *KubernetesObjectConfig) {
+func (in *PushSecretDestination) DeepCopyInto(out *PushSecretDestination) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(KubernetesAuth)
-		(*in).DeepCopyInto(*out)
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.LabelSelector != nil {
-		in, out := &in.LabelSelector, &out.LabelSelector
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesObjectConfig.
-func (in *KubernetesObjectConfig) DeepCopy() *KubernetesObjectConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushSecretDestination.
+func (in *PushSecretDestination) DeepCopy() *PushSecretDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesObjectConfig)
+	out := new(PushSecretDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSecretConfig) DeepCopyInto(out *KubernetesSecretConfig) {
+func (in *RedisConfig) DeepCopyInto(out *RedisConfig) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(KubernetesAuth)
+	if in.Sentinel != nil {
+		in, out := &in.Sentinel, &out.Sentinel
+		*out = new(RedisSentinel)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LabelSelector != nil {
-		in, out := &in.LabelSelector, &out.LabelSelector
-		*out = new(v1.LabelSelector)
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(RedisStream)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretConfig.
-func (in *KubernetesSecretConfig) DeepCopy() *KubernetesSecretConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisConfig.
+func (in *RedisConfig) DeepCopy() *RedisConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSecretConfig)
+	out := new(RedisConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchStrategy) DeepCopyInto(out *MatchStrategy) {
+func (in *RedisSentinel) DeepCopyInto(out *RedisSentinel) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchStrategy.
-func (in *MatchStrategy) DeepCopy() *MatchStrategy {
-	if in == nil {
-		return nil
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
 	}
-	out := new(MatchStrategy)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MockConfig) DeepCopyInto(out *MockConfig) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MockConfig.
-func (in *MockConfig) DeepCopy() *MockConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSentinel.
+func (in *RedisSentinel) DeepCopy() *RedisSentinel {
 	if in == nil {
 		return nil
 	}
-	out := new(MockConfig)
+	out := new(RedisSentinel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationSource) DeepCopyInto(out *NotificationSource) {
+func (in *RedisStream) DeepCopyInto(out *RedisStream) {
 	*out = *in
-	if in.AwsSqs != nil {
-		in, out := &in.AwsSqs, &out.AwsSqs
-		*out = new(AWSSQSConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AzureEventGrid != nil {
-		in, out := &in.AzureEventGrid, &out.AzureEventGrid
-		*out = new(AzureEventGridConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GooglePubSub != nil {
-		in, out := &in.GooglePubSub, &out.GooglePubSub
-		*out = new(GooglePubSubConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Webhook != nil {
-		in, out := &in.Webhook, &out.Webhook
-		*out = new(WebhookConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HashicorpVault != nil {
-		in, out := &in.HashicorpVault, &out.HashicorpVault
-		*out = new(HashicorpVaultConfig)
-		**out = **in
-	}
-	if in.KubernetesSecret != nil {
-		in, out := &in.KubernetesSecret, &out.KubernetesSecret
-		*out = new(KubernetesSecretConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KubernetesConfigMap != nil {
-		in, out := &in.KubernetesConfigMap, &out.KubernetesConfigMap
-		*out = new(KubernetesConfigMapConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TCPSocket != nil {
-		in, out := &in.TCPSocket, &out.TCPSocket
-		*out = new(TCPSocketConfig)
-		**out = **in
-	}
-	if in.Mock != nil {
-		in, out := &in.Mock, &out.Mock
-		*out = new(MockConfig)
+	if in.ReclaimMinIdle != nil {
+		in, out := &in.ReclaimMinIdle, &out.ReclaimMinIdle
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSource.
-func (in *NotificationSource) DeepCopy() *NotificationSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStream.
+func (in *RedisStream) DeepCopy() *RedisStream {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationSource)
+	out := new(RedisStream)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PatchOperationConfig) DeepCopyInto(out *PatchOperationConfig) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchOperationConfig.
-func (in *PatchOperationConfig) DeepCopy() *PatchOperationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(PatchOperationConfig)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PushSecretDestination) DeepCopyInto(out *PushSecretDestination) {
+func (in *RolloutDestination) DeepCopyInto(out *RolloutDestination) {
 	*out = *in
 	if in.NamespaceSelectors != nil {
 		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
@@ -720,27 +2110,12 @@ func (in *PushSecretDestination) DeepCopyInto(out *PushSecretDestination) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushSecretDestination.
-func (in *PushSecretDestination) DeepCopy() *PushSecretDestination {
-	if in == nil {
-		return nil
-	}
-	out := new(PushSecretDestination)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
-func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutDestination.
+func (in *RolloutDestination) DeepCopy() *RolloutDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryPolicy)
+	out := new(RolloutDestination)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -795,6 +2170,36 @@ func (in *TCPSocketConfig) DeepCopy() *TCPSocketConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.CertRef != nil {
+		in, out := &in.CertRef, &out.CertRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRef) DeepCopyInto(out *TokenRef) {
 	*out = *in
@@ -904,6 +2309,11 @@ func (in *WebhookAuth) DeepCopyInto(out *WebhookAuth) {
 		*out = new(BearerToken)
 		**out = **in
 	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAuth.
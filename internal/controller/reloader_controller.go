@@ -5,13 +5,16 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/external-secrets-inc/reloader/api/v1alpha1"
 	"github.com/external-secrets-inc/reloader/internal/events"
 	"github.com/external-secrets-inc/reloader/internal/handler"
 	"github.com/external-secrets-inc/reloader/internal/listener"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,6 +32,9 @@ const (
 	EventActionDeleted  EventAction = "Deleted"
 	ProcessedAnnotation string      = "reloader/processed"
 	reloaderFinalizer               = "reloader.external-secrets.io/finalizer"
+
+	// clusterHealthInterval is how often the health of remote destination clusters is checked.
+	clusterHealthInterval = time.Minute
 )
 
 // ReloaderReconciler reconciles an Reloader object
@@ -86,14 +92,30 @@ func (r *ReloaderReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups=reloader.external-secrets.io,resources=configs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=reloader.external-secrets.io,resources=configs/status,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=reloader.external-secrets.io,resources=configs/finalizers,verbs=update
-// For k8s ExternalSecrets and PushSecrets destination
-// +kubebuilder:rbac:groups=external-secrets.io,resources=externalsecrets;pushsecrets,verbs=get;list;watch;update;patch
+// For k8s ExternalSecrets, ClusterExternalSecrets and PushSecrets destination
+// +kubebuilder:rbac:groups=external-secrets.io,resources=externalsecrets;clusterexternalsecrets;pushsecrets,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=workflows.external-secrets.io,resources=workflowruntemplates,verbs=get;list;watch;update;patch
 // For k8s Deployments destination
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// For k8s CronJobs and Jobs destinations
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// For Pods destination
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+// For Argo Rollouts destination
+// +kubebuilder:rbac:groups=argoproj.io,resources=rollouts,verbs=get;list;watch;update;patch
+// For Flux and Argo CD destinations
+// +kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=helm.toolkit.fluxcd.io,resources=helmreleases,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=applications,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update;patch
 // For k8s Secret notification source
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// For k8s ConfigMap notification source, Webhook OIDC JWKS stored in ConfigMaps and the state of polling notification sources
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+// For ServiceAccount tokens used by the HashicorpVaultPoll Kubernetes auth
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 
 // Reconcile reconciles a Config object, ensuring that the internal state aligns with the desired state.
 // It fetches the Reloader instance, updates the internal cache, and manages notification listeners.
@@ -178,9 +200,62 @@ func (r *ReloaderReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
+	if err := r.updateClusterStatus(ctx, &cfg); err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(cfg.Status.Clusters) > 0 {
+		return ctrl.Result{RequeueAfter: clusterHealthInterval}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// updateClusterStatus reports the health of the remote destination clusters.
+// The status is only patched if it changed.
+func (r *ReloaderReconciler) updateClusterStatus(ctx context.Context, cfg *v1alpha1.Config) error {
+	health := r.eventHandler.ClusterHealth(ctx, cfg.Spec.DestinationsToWatch)
+	clusters := clusterStatuses(cfg.Status.Clusters, health, metav1.Now())
+	if equality.Semantic.DeepEqual(clusters, cfg.Status.Clusters) {
+		return nil
+	}
+	patch := client.MergeFrom(cfg.DeepCopy())
+	cfg.Status.Clusters = clusters
+	if err := r.Status().Patch(ctx, cfg, patch); err != nil {
+		return fmt.Errorf("could not update cluster status: %w", err)
+	}
+	return nil
+}
+
+// clusterStatuses builds the cluster statuses from the health checks, sorted by name and ID.
+// LastTransitionTime is kept from current unless Ready changed.
+func clusterStatuses(current []v1alpha1.ClusterStatus, health map[string]handler.ClusterHealthResult, now metav1.Time) []v1alpha1.ClusterStatus {
+	if len(health) == 0 {
+		return nil
+	}
+	previous := map[string]v1alpha1.ClusterStatus{}
+	for _, s := range current {
+		previous[s.ID] = s
+	}
+	clusters := make([]v1alpha1.ClusterStatus, 0, len(health))
+	for id, result := range health {
+		s := v1alpha1.ClusterStatus{Name: result.Name, ID: id, Ready: result.Err == nil, LastTransitionTime: now}
+		if result.Err != nil {
+			s.Message = result.Err.Error()
+		}
+		if p, ok := previous[id]; ok && p.Ready == s.Ready {
+			s.LastTransitionTime = p.LastTransitionTime
+		}
+		clusters = append(clusters, s)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Name != clusters[j].Name {
+			return clusters[i].Name < clusters[j].Name
+		}
+		return clusters[i].ID < clusters[j].ID
+	})
+	return clusters
+}
+
 // processEvents listens for SecretRotationEvents and handles them.
 func (r *ReloaderReconciler) processEvents(ctx context.Context) {
 	logger := log.FromContext(ctx)
@@ -0,0 +1,266 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// refreshAnnotation requests a refresh. Argo CD removes it once the refresh is done.
+	refreshAnnotation = "argocd.argoproj.io/refresh"
+	// syncInitiator is reported as the user that started sync operations.
+	syncInitiator        = "external-secrets-reloader"
+	applicationTimeout   = 10 * time.Minute
+	applicationPollEvery = 100 * time.Millisecond
+)
+
+// errApplicationFailed is returned by WaitFor when the sync failed or the Application is degraded.
+var errApplicationFailed = errors.New("application failed")
+
+var applicationGVK = kruntime.GroupVersionKind{
+	Group:   "argoproj.io",
+	Version: "v1alpha1",
+	Kind:    "Application",
+}
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+// Filter ignores the event namespace, as Applications usually live in the Argo CD namespace rather than the one they deploy to.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.ArgoCDApplication == nil {
+		return nil, errors.New("destination isn't type ArgoCDApplication")
+	}
+	logger := log.FromContext(h.ctx)
+	apps := &unstructured.UnstructuredList{}
+	apps.SetGroupVersionKind(applicationGVK.GroupVersion().WithKind(applicationGVK.Kind + "List"))
+	if err := h.client.List(h.ctx, apps); err != nil {
+		return nil, fmt.Errorf("failed to list Applications: %w", err)
+	}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		isWatched, err := h.isResourceWatched(app, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if Application is watched", "name", app.GetName(), "namespace", app.GetNamespace())
+			continue
+		}
+		if isWatched {
+			objs = append(objs, app)
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply requests a refresh of the Application and, if configured, starts a sync operation.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	app, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return errors.New("obj isn't type Application")
+	}
+	cfg := h.destinationCache.ArgoCDApplication
+	if cfg == nil {
+		cfg = &v1alpha1.ArgoCDApplicationDestination{}
+	}
+
+	annotations := app.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	refresh := v1alpha1.ArgoCDRefreshNormal
+	if cfg.Refresh != "" {
+		refresh = cfg.Refresh
+	}
+	annotations[refreshAnnotation] = strings.ToLower(string(refresh))
+	annotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+	annotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+	app.SetAnnotations(annotations)
+
+	if cfg.Sync {
+		if _, inProgress, _ := unstructured.NestedMap(app.Object, "operation"); inProgress {
+			logger.Info("Application has an operation in progress, only refreshing", "name", app.GetName(), "namespace", app.GetNamespace())
+		} else {
+			operation := map[string]any{
+				"initiatedBy": map[string]any{"username": syncInitiator},
+				"sync":        map[string]any{},
+			}
+			if err := unstructured.SetNestedMap(app.Object, operation, "operation"); err != nil {
+				return fmt.Errorf("failed to set sync operation: %w", err)
+			}
+		}
+	}
+
+	if err := h.client.Update(h.ctx, app); err != nil {
+		return fmt.Errorf("failed to update Application:%w", err)
+	}
+	logger.V(1).Info("Requested Application refresh", "name", app.GetName(), "namespace", app.GetNamespace(), "sync", cfg.Sync)
+	return nil
+}
+
+// isResourceWatched determines if a single Application matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(obj client.Object, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.ArgoCDApplication
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not ArgoCDApplication")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, obj, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, obj, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(obj, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor waits until the Application is refreshed, synced if requested, and Healthy.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	sync := h.destinationCache.ArgoCDApplication != nil && h.destinationCache.ArgoCDApplication.Sync
+
+	logger.V(1).Info("Waiting for Application to be refreshed", "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	ticker := time.NewTicker(applicationPollEvery)
+	defer ticker.Stop()
+
+	timeout := time.After(applicationTimeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for application %s/%s", obj.GetNamespace(), obj.GetName())
+		case <-ticker.C:
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(applicationGVK)
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				return fmt.Errorf("failed to get application: %w", err)
+			}
+			done, err := isApplicationReady(current, sync)
+			if err != nil {
+				return fmt.Errorf("application %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			if done {
+				logger.V(1).Info("Application is ready", "name", obj.GetName(), "namespace", obj.GetNamespace())
+				return nil
+			}
+		}
+	}
+}
+
+// isApplicationReady checks the refresh, the sync operation if sync is set, and the health of the Application.
+func isApplicationReady(app *unstructured.Unstructured, sync bool) (bool, error) {
+	if _, refreshing := app.GetAnnotations()[refreshAnnotation]; refreshing {
+		return false, nil
+	}
+	if sync {
+		if _, pending, _ := unstructured.NestedMap(app.Object, "operation"); pending {
+			return false, nil
+		}
+		phase, _, _ := unstructured.NestedString(app.Object, "status", "operationState", "phase")
+		message, _, _ := unstructured.NestedString(app.Object, "status", "operationState", "message")
+		switch phase {
+		case "Failed", "Error":
+			return false, fmt.Errorf("%w: sync %s: %s", errApplicationFailed, strings.ToLower(phase), message)
+		case "Succeeded":
+		default:
+			return false, nil
+		}
+	}
+	health, _, _ := unstructured.NestedString(app.Object, "status", "health", "status")
+	switch health {
+	case "Healthy", "Suspended":
+		return true, nil
+	case "Degraded":
+		message, _, _ := unstructured.NestedString(app.Object, "status", "health", "message")
+		return false, fmt.Errorf("%w: degraded: %s", errApplicationFailed, message)
+	}
+	return false, nil
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references returns true always - as Applications usually reference secrets indirectly, through the manifests they render.
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	return true, nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
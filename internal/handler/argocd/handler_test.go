@@ -0,0 +1,101 @@
+package argocd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newApplication(name string, annotations map[string]any, extra map[string]any) *unstructured.Unstructured {
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": "argocd", "annotations": annotations},
+	}}
+	for k, v := range extra {
+		app.Object[k] = v
+	}
+	app.SetGroupVersionKind(applicationGVK)
+	return app
+}
+
+func TestIsApplicationReady(t *testing.T) {
+	status := func(phase, health string) map[string]any {
+		return map[string]any{"status": map[string]any{
+			"operationState": map[string]any{"phase": phase, "message": "hook failed"},
+			"health":         map[string]any{"status": health},
+		}}
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]any
+		extra       map[string]any
+		sync        bool
+		wantDone    bool
+		wantErr     bool
+	}{
+		{name: "healthy", extra: status("", "Healthy"), wantDone: true},
+		{name: "refreshing", annotations: map[string]any{refreshAnnotation: "normal"}, extra: status("", "Healthy")},
+		{name: "progressing", extra: status("", "Progressing")},
+		{name: "degraded", extra: status("", "Degraded"), wantErr: true},
+		{name: "synced", sync: true, extra: status("Succeeded", "Healthy"), wantDone: true},
+		{name: "syncing", sync: true, extra: status("Running", "Healthy")},
+		{name: "sync failed", sync: true, extra: status("Failed", "Healthy"), wantErr: true},
+		{name: "sync not started", sync: true, extra: map[string]any{"operation": map[string]any{"sync": map[string]any{}}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			done, err := isApplicationReady(newApplication("app", tc.annotations, tc.extra), tc.sync)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errApplicationFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDone, done)
+		})
+	}
+}
+
+func TestApplicationHandlerApply(t *testing.T) {
+	running := map[string]any{"operation": map[string]any{"initiatedBy": map[string]any{"username": "admin"}, "sync": map[string]any{"revision": "abc"}}}
+	testCases := []struct {
+		name          string
+		destination   *v1alpha1.ArgoCDApplicationDestination
+		extra         map[string]any
+		wantRefresh   string
+		wantInitiator string
+	}{
+		{name: "refresh", destination: &v1alpha1.ArgoCDApplicationDestination{}, wantRefresh: "normal"},
+		{name: "hard refresh and sync", destination: &v1alpha1.ArgoCDApplicationDestination{Refresh: v1alpha1.ArgoCDRefreshHard, Sync: true}, wantRefresh: "hard", wantInitiator: syncInitiator},
+		{name: "operation in progress", destination: &v1alpha1.ArgoCDApplicationDestination{Sync: true}, extra: running, wantRefresh: "normal", wantInitiator: "admin"},
+	}
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test", Namespace: "payments"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newApplication("payments", nil, tc.extra)).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "ArgoCDApplication", ArgoCDApplication: tc.destination}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 1, "applications are not filtered by the event namespace")
+			require.NoError(t, h.Apply(objs[0], event))
+
+			updated := &unstructured.Unstructured{}
+			updated.SetGroupVersionKind(applicationGVK)
+			require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "argocd", Name: "payments"}, updated))
+			assert.Equal(t, tc.wantRefresh, updated.GetAnnotations()[refreshAnnotation])
+			initiator, _, _ := unstructured.NestedString(updated.Object, "operation", "initiatedBy", "username")
+			assert.Equal(t, tc.wantInitiator, initiator)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package clusterexternalsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/externalsecret"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	esov1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	readyTimeout   = 10 * time.Minute
+	readyPollEvery = 100 * time.Millisecond
+)
+
+// errNotReady is returned by WaitFor when the ClusterExternalSecret reports it is not Ready.
+var errNotReady = errors.New("cluster external secret not ready")
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+
+	// syncedBefore is the synced version of every ExternalSecret produced by the ClusterExternalSecrets, before the refresh request.
+	syncedBefore map[types.NamespacedName]string
+}
+
+// Filter ignores the event namespace, as ClusterExternalSecrets are cluster scoped.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.ClusterExternalSecret == nil {
+		return nil, errors.New("destination isn't type ClusterExternalSecret")
+	}
+	logger := log.FromContext(h.ctx)
+	var clusterExternalSecrets esov1.ClusterExternalSecretList
+	if err := h.client.List(h.ctx, &clusterExternalSecrets); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterExternalSecrets:%w", err)
+	}
+	for key, ces := range clusterExternalSecrets.Items {
+		isWatched, err := h.isResourceWatched(&clusterExternalSecrets.Items[key], h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if ClusterExternalSecret is watched", "name", ces.Name)
+			continue
+		}
+		if isWatched {
+			objs = append(objs, &clusterExternalSecrets.Items[key])
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply refreshes the ExternalSecrets produced by the ClusterExternalSecret if RefreshExternalSecrets is set.
+// Otherwise, it sets the force-sync annotation on the ClusterExternalSecret, which ESO mirrors to the ExternalSecrets it produces.
+// Either way, it first records the synced version of the ExternalSecrets, for WaitFor to detect their refresh.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	ces, ok := obj.(*esov1.ClusterExternalSecret)
+	if !ok {
+		return errors.New("obj isn't type ClusterExternalSecret")
+	}
+	refresh := h.destinationCache.ClusterExternalSecret != nil && h.destinationCache.ClusterExternalSecret.RefreshExternalSecrets
+	err := h.forEachExternalSecret(ces, func(es *esov1.ExternalSecret, handler *externalsecret.Handler) error {
+		h.syncedBefore[client.ObjectKeyFromObject(es)] = es.Status.SyncedResourceVersion
+		if !refresh {
+			return nil
+		}
+		return handler.Apply(es, event)
+	})
+	if refresh {
+		return err
+	}
+	if err != nil {
+		// ExternalSecrets not provisioned yet are waited on until their first sync.
+		logger.Error(err, "failed to get ExternalSecrets of ClusterExternalSecret", "name", ces.GetName())
+	}
+
+	annotations := ces.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[esov1.AnnotationForceSync] = time.Now().UTC().Format(time.RFC3339)
+	annotations["reloader/last-rotated"] = event.RotationTimestamp
+	annotations["reloader/trigger-source"] = event.TriggerSource
+	ces.SetAnnotations(annotations)
+
+	if err := h.client.Update(h.ctx, ces); err != nil {
+		return fmt.Errorf("failed to update ClusterExternalSecret:%w", err)
+	}
+	logger.V(1).Info("Annotated ClusterExternalSecret", "name", ces.GetName())
+	return nil
+}
+
+// forEachExternalSecret calls fn with every ExternalSecret produced by the ClusterExternalSecret,
+// and the ExternalSecret handler to refresh it.
+func (h *Handler) forEachExternalSecret(ces *esov1.ClusterExternalSecret, fn func(*esov1.ExternalSecret, *externalsecret.Handler) error) error {
+	handler := (&externalsecret.Provider{}).NewHandler(h.ctx, h.client, v1alpha1.DestinationToWatch{
+		Type:           schema.EXTERNAL_SECRET,
+		ExternalSecret: &v1alpha1.ExternalSecretDestination{},
+	}).(*externalsecret.Handler)
+	name := externalSecretName(ces)
+	var errs []error
+	for _, namespace := range ces.Status.ProvisionedNamespaces {
+		es := &esov1.ExternalSecret{}
+		if err := h.client.Get(h.ctx, client.ObjectKey{Namespace: namespace, Name: name}, es); err != nil {
+			errs = append(errs, fmt.Errorf("failed to get ExternalSecret %s/%s: %w", namespace, name, err))
+			continue
+		}
+		if err := fn(es, handler); err != nil {
+			errs = append(errs, fmt.Errorf("ExternalSecret %s/%s: %w", namespace, name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// externalSecretName returns the name of the ExternalSecrets produced by the ClusterExternalSecret.
+func externalSecretName(ces *esov1.ClusterExternalSecret) string {
+	switch {
+	case ces.Status.ExternalSecretName != "":
+		return ces.Status.ExternalSecretName
+	case ces.Spec.ExternalSecretName != "":
+		return ces.Spec.ExternalSecretName
+	}
+	return ces.Name
+}
+
+// isResourceWatched determines if a single ClusterExternalSecret matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(ces *esov1.ClusterExternalSecret, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.ClusterExternalSecret
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not ClusterExternalSecret")
+	}
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, ces, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(ces, nameSet)
+	return labelMatch && nameMatch, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor waits for the ExternalSecrets produced by the ClusterExternalSecret to sync,
+// then for the ClusterExternalSecret to be Ready.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	ces, ok := obj.(*esov1.ClusterExternalSecret)
+	if !ok {
+		return errors.New("obj isn't type ClusterExternalSecret")
+	}
+	err := h.forEachExternalSecret(ces, func(es *esov1.ExternalSecret, handler *externalsecret.Handler) error {
+		// ESO may have refreshed the ExternalSecret since Apply, so compare to the version recorded then.
+		return handler.WaitForRefresh(es, h.syncedBefore[client.ObjectKeyFromObject(es)])
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.V(1).Info("Waiting for ClusterExternalSecret to be Ready", "name", ces.GetName())
+
+	ticker := time.NewTicker(readyPollEvery)
+	defer ticker.Stop()
+
+	timeout := time.After(readyTimeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for ClusterExternalSecret %s to be Ready", ces.GetName())
+		case <-ticker.C:
+			current := &esov1.ClusterExternalSecret{}
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(ces), current); err != nil {
+				return fmt.Errorf("failed to get ClusterExternalSecret: %w", err)
+			}
+			ready, err := isReady(current)
+			if err != nil {
+				return fmt.Errorf("ClusterExternalSecret %s: %w", ces.GetName(), err)
+			}
+			if ready {
+				logger.V(1).Info("ClusterExternalSecret is Ready", "name", ces.GetName())
+				return nil
+			}
+		}
+	}
+}
+
+// isReady checks the Ready condition of the ClusterExternalSecret, reporting the failed namespaces if it is not Ready.
+func isReady(ces *esov1.ClusterExternalSecret) (bool, error) {
+	for _, condition := range ces.Status.Conditions {
+		if condition.Type != esov1.ClusterExternalSecretReady {
+			continue
+		}
+		switch condition.Status {
+		case corev1.ConditionTrue:
+			return true, nil
+		case corev1.ConditionFalse:
+			reasons := []string{condition.Message}
+			for _, f := range ces.Status.FailedNamespaces {
+				reasons = append(reasons, fmt.Sprintf("namespace %s: %s", f.Namespace, f.Reason))
+			}
+			return false, fmt.Errorf("%w: %s", errNotReady, strings.Join(reasons, "; "))
+		}
+	}
+	return false, nil
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references checks if the ExternalSecret spec of the ClusterExternalSecret references the given secret identifier.
+// It is the default References implementation
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	ces, ok := obj.(*esov1.ClusterExternalSecret)
+	if !ok {
+		return false, errors.New("obj isn't type ClusterExternalSecret")
+	}
+	return externalsecret.SpecReferences(&ces.Spec.ExternalSecretSpec, secretIdentifier), nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
@@ -0,0 +1,185 @@
+package clusterexternalsecret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	esov1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, esov1.AddToScheme(s))
+	return s
+}
+
+func newClusterExternalSecret(name, key string) *esov1.ClusterExternalSecret {
+	return &esov1.ClusterExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: esov1.ClusterExternalSecretSpec{
+			ExternalSecretName: "shared",
+			ExternalSecretSpec: esov1.ExternalSecretSpec{
+				Data: []esov1.ExternalSecretData{{SecretKey: "password", RemoteRef: esov1.ExternalSecretDataRemoteRef{Key: key}}},
+			},
+		},
+		Status: esov1.ClusterExternalSecretStatus{ProvisionedNamespaces: []string{"team-a", "team-b"}},
+	}
+}
+
+func newExternalSecret(namespace string) *esov1.ExternalSecret {
+	return &esov1.ExternalSecret{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: namespace}}
+}
+
+func TestIsReady(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []esov1.ClusterExternalSecretStatusCondition
+		wantReady  bool
+		wantErr    bool
+	}{
+		{name: "no condition"},
+		{name: "ready", conditions: []esov1.ClusterExternalSecretStatusCondition{{Type: esov1.ClusterExternalSecretReady, Status: corev1.ConditionTrue}}, wantReady: true},
+		{name: "unknown", conditions: []esov1.ClusterExternalSecretStatusCondition{{Type: esov1.ClusterExternalSecretReady, Status: corev1.ConditionUnknown}}},
+		{name: "not ready", conditions: []esov1.ClusterExternalSecretStatusCondition{{Type: esov1.ClusterExternalSecretReady, Status: corev1.ConditionFalse}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ces := newClusterExternalSecret("db", "db")
+			ces.Status.Conditions = tc.conditions
+			ces.Status.FailedNamespaces = []esov1.ClusterExternalSecretNamespaceFailure{{Namespace: "team-b", Reason: "denied"}}
+			ready, err := isReady(ces)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errNotReady)
+				assert.ErrorContains(t, err, "namespace team-b: denied")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantReady, ready)
+		})
+	}
+}
+
+func TestClusterExternalSecretHandlerApply(t *testing.T) {
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+	testCases := []struct {
+		name             string
+		refresh          bool
+		wantCESAnnotated bool
+		wantESAnnotated  bool
+	}{
+		{name: "annotates ClusterExternalSecret", wantCESAnnotated: true},
+		{name: "refreshes ExternalSecrets", refresh: true, wantESAnnotated: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(
+				newClusterExternalSecret("db", "db"),
+				newClusterExternalSecret("api", "api-key"),
+				newExternalSecret("team-a"),
+				newExternalSecret("team-b"),
+			).Build()
+			destination := v1alpha1.DestinationToWatch{
+				Type:                  "ClusterExternalSecret",
+				ClusterExternalSecret: &v1alpha1.ClusterExternalSecretDestination{RefreshExternalSecrets: tc.refresh},
+			}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 2)
+			var referenced []client.Object
+			for _, obj := range objs {
+				ok, err := h.References(obj, event.SecretIdentifier)
+				require.NoError(t, err)
+				if ok {
+					referenced = append(referenced, obj)
+				}
+			}
+			require.Len(t, referenced, 1)
+			require.NoError(t, h.Apply(referenced[0], event))
+
+			ces := &esov1.ClusterExternalSecret{}
+			require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "db"}, ces))
+			assert.Equal(t, tc.wantCESAnnotated, ces.Annotations[esov1.AnnotationForceSync] != "")
+			for _, namespace := range []string{"team-a", "team-b"} {
+				es := &esov1.ExternalSecret{}
+				require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "shared"}, es))
+				assert.Equal(t, tc.wantESAnnotated, es.Annotations[esov1.AnnotationForceSync] != "")
+			}
+		})
+	}
+}
+
+func TestClusterExternalSecretHandlerWaitForNotRefreshable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ces := newClusterExternalSecret("db", "db")
+	ces.Spec.ExternalSecretSpec.RefreshPolicy = esov1.RefreshPolicyCreatedOnce
+	ces.Status.Conditions = []esov1.ClusterExternalSecretStatusCondition{{Type: esov1.ClusterExternalSecretReady, Status: corev1.ConditionTrue}}
+	var objs []client.Object
+	for _, namespace := range []string{"team-a", "team-b"} {
+		es := newExternalSecret(namespace)
+		es.Spec = ces.Spec.ExternalSecretSpec
+		es.Status.SyncedResourceVersion = "1-abc"
+		objs = append(objs, es)
+	}
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(append(objs, ces)...).Build()
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	for _, refresh := range []bool{false, true} {
+		destination := v1alpha1.DestinationToWatch{
+			Type:                  "ClusterExternalSecret",
+			ClusterExternalSecret: &v1alpha1.ClusterExternalSecretDestination{RefreshExternalSecrets: refresh},
+		}
+		h := (&Provider{}).NewHandler(ctx, c, destination)
+		current := &esov1.ClusterExternalSecret{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(ces), current))
+		require.NoError(t, h.Apply(current, event))
+		assert.NoError(t, h.WaitFor(current))
+	}
+}
+
+func TestClusterExternalSecretHandlerWaitForRefreshedBeforeWait(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ces := newClusterExternalSecret("db", "db")
+	ces.Status.Conditions = []esov1.ClusterExternalSecretStatusCondition{{Type: esov1.ClusterExternalSecretReady, Status: corev1.ConditionTrue}}
+	objs := []client.Object{ces}
+	for _, namespace := range []string{"team-a", "team-b"} {
+		es := newExternalSecret(namespace)
+		es.Status.SyncedResourceVersion = "1-abc"
+		objs = append(objs, es)
+	}
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(objs...).Build()
+	destination := v1alpha1.DestinationToWatch{Type: "ClusterExternalSecret", ClusterExternalSecret: &v1alpha1.ClusterExternalSecretDestination{}}
+	h := (&Provider{}).NewHandler(ctx, c, destination)
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	current := &esov1.ClusterExternalSecret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(ces), current))
+	require.NoError(t, h.Apply(current, event))
+	// ESO refreshes the ExternalSecrets before WaitFor gets them.
+	for _, namespace := range []string{"team-a", "team-b"} {
+		es := &esov1.ExternalSecret{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "shared"}, es))
+		es.Status.SyncedResourceVersion = "1-def"
+		es.Status.Conditions = []esov1.ExternalSecretStatusCondition{{Type: esov1.ExternalSecretReady, Status: corev1.ConditionTrue}}
+		require.NoError(t, c.Update(ctx, es))
+	}
+	assert.NoError(t, h.WaitFor(current))
+}
@@ -0,0 +1,207 @@
+package cronjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// instantiateAnnotation marks Jobs created by hand from a CronJob, as `kubectl create job --from` does.
+const instantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.CronJob == nil {
+		return nil, errors.New("destination isn't type CronJob")
+	}
+	logger := log.FromContext(h.ctx)
+	var cronJobs batchv1.CronJobList
+	var opts []client.ListOption
+	if event.Namespace != "" {
+		opts = append(opts, client.InNamespace(event.Namespace))
+	}
+	if err := h.client.List(h.ctx, &cronJobs, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list CronJobs:%w", err)
+	}
+	for key, cronJob := range cronJobs.Items {
+		isWatched, err := h.isResourceWatched(cronJob, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if CronJob is watched", "name", cronJob.Name, "namespace", cronJob.Namespace)
+			continue
+		}
+		if isWatched {
+			objs = append(objs, &cronJobs.Items[key])
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply annotates the job template so the next scheduled Job picks up the rotated secret.
+// With RunNow, a one-off Job is also created from the updated CronJob.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	cronJob, ok := obj.(*batchv1.CronJob)
+	if !ok {
+		return errors.New("obj isn't type CronJob")
+	}
+	tpl := cronJob.Spec.JobTemplate.Spec.Template
+	annotations := tpl.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+	annotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+
+	tpl.SetAnnotations(annotations)
+	cronJob.Spec.JobTemplate.Spec.Template = tpl
+	if err := h.client.Update(h.ctx, cronJob); err != nil {
+		return fmt.Errorf("failed to update CronJob:%w", err)
+	}
+	logger.V(1).Info("Annotated CronJob", "name", cronJob.GetName(), "namespace", cronJob.GetNamespace())
+
+	if h.destinationCache.CronJob == nil || !h.destinationCache.CronJob.RunNow {
+		return nil
+	}
+	job := jobFromCronJob(cronJob, util.SuffixedName(cronJob.Name, event))
+	if err := h.client.Create(h.ctx, job); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create Job from CronJob:%w", err)
+	}
+	logger.V(1).Info("Created Job from CronJob", "name", job.GetName(), "namespace", job.GetNamespace(), "cronJob", cronJob.GetName())
+	return nil
+}
+
+// jobFromCronJob builds a Job from the job template of the CronJob the same way `kubectl create job --from` does.
+func jobFromCronJob(cronJob *batchv1.CronJob, name string) *batchv1.Job {
+	annotations := map[string]string{instantiateAnnotation: "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   cronJob.Namespace,
+			Annotations: annotations,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
+
+// isResourceWatched determines if a single CronJob matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(cronJob batchv1.CronJob, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.CronJob
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not CronJob")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, &cronJob, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, &cronJob, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(&cronJob, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor does not wait: the annotated template is only used by the next Job.
+func (h *Handler) _waitFor(obj client.Object) error {
+	return nil
+}
+
+func (h *Handler) References(obj client.Object, identifier string) (bool, error) {
+	return h.referenceFn(obj, identifier)
+}
+
+// _references checks if the job template of the CronJob references the given secret identifier.
+// It is the default References implementation
+func (h *Handler) _references(obj client.Object, identifier string) (bool, error) {
+	cronJob, ok := obj.(*batchv1.CronJob)
+	if !ok {
+		return false, errors.New("obj isn't type CronJob")
+	}
+	return util.PodSpecReferences(&cronJob.Spec.JobTemplate.Spec.Template.Spec, identifier), nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
@@ -0,0 +1,93 @@
+package cronjob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCronJobHandler(t *testing.T) {
+	newCronJob := func(name string, volume corev1.Volume) *batchv1.CronJob {
+		return &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+			Spec: batchv1.CronJobSpec{
+				Schedule: "0 * * * *",
+				JobTemplate: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": name}},
+					Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "backup", Image: "backup"}},
+						Volumes:    []corev1.Volume{volume},
+					}}},
+				},
+			},
+		}
+	}
+	secretVolume := corev1.Volume{Name: "creds", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "db"}}}
+	configMapVolume := corev1.Volume{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "settings"},
+	}}}
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test", Namespace: "default"}
+
+	testCases := []struct {
+		name     string
+		runNow   bool
+		wantJobs int
+	}{
+		{name: "annotate only"},
+		{name: "run now", runNow: true, wantJobs: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				newCronJob("backup", secretVolume),
+				newCronJob("report", configMapVolume),
+			).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "CronJob", CronJob: &v1alpha1.CronJobDestination{RunNow: tc.runNow}}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 2)
+			var referenced []client.Object
+			for _, obj := range objs {
+				ok, err := h.References(obj, event.SecretIdentifier)
+				require.NoError(t, err)
+				if ok {
+					referenced = append(referenced, obj)
+				}
+			}
+			require.Len(t, referenced, 1)
+			require.NoError(t, h.Apply(referenced[0], event))
+			// Applying the same event twice does not create another Job.
+			require.NoError(t, h.Apply(referenced[0], event))
+
+			var updated batchv1.CronJob
+			require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "backup"}, &updated))
+			assert.Equal(t, event.RotationTimestamp, updated.Spec.JobTemplate.Spec.Template.Annotations["reloader.external-secrets.io/last-reloaded"])
+
+			var jobs batchv1.JobList
+			require.NoError(t, c.List(ctx, &jobs))
+			require.Len(t, jobs.Items, tc.wantJobs)
+			if tc.wantJobs > 0 {
+				job := jobs.Items[0]
+				assert.Equal(t, "manual", job.Annotations[instantiateAnnotation])
+				assert.Equal(t, "backup", job.Labels["app"])
+				assert.Equal(t, "backup", job.OwnerReferences[0].Name)
+				assert.Equal(t, event.RotationTimestamp, job.Spec.Template.Annotations["reloader.external-secrets.io/last-reloaded"])
+			}
+		})
+	}
+}
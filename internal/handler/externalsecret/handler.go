@@ -5,18 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/external-secrets-inc/reloader/api/v1alpha1"
 	"github.com/external-secrets-inc/reloader/internal/events"
 	"github.com/external-secrets-inc/reloader/internal/handler/schema"
 	"github.com/external-secrets-inc/reloader/internal/util"
 	esov1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const (
+	syncTimeout   = 10 * time.Minute
+	syncPollEvery = 100 * time.Millisecond
+)
+
+// errSyncFailed is returned by WaitFor when the ExternalSecret failed to sync.
+var errSyncFailed = errors.New("sync failed")
+
 type Handler struct {
 	ctx              context.Context
 	client           client.Client
@@ -42,9 +52,14 @@ func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.
 			logger.Error(err, "failed to check if ExternalSecret is watched", "name", es.Name, "namespace", es.Namespace)
 			continue
 		}
-		if isWatched {
-			objs = append(objs, &externalSecrets.Items[key])
+		if !isWatched {
+			continue
+		}
+		if h.destinationCache.ExternalSecret.RespectRefreshPolicy && !refreshable(&externalSecrets.Items[key]) {
+			logger.V(1).Info("skipping ExternalSecret as its refreshPolicy disallows refreshing", "name", es.Name, "namespace", es.Namespace)
+			continue
 		}
+		objs = append(objs, &externalSecrets.Items[key])
 	}
 	return objs, nil
 }
@@ -53,6 +68,7 @@ func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) err
 	return h.applyFn(obj, event)
 }
 
+// _apply requests a refresh of the ExternalSecret through the force-sync annotation.
 func (h *Handler) _apply(es client.Object, event events.SecretRotationEvent) error {
 	logger := log.FromContext(h.ctx)
 
@@ -61,6 +77,7 @@ func (h *Handler) _apply(es client.Object, event events.SecretRotationEvent) err
 		annotations = make(map[string]string)
 	}
 
+	annotations[esov1.AnnotationForceSync] = time.Now().UTC().Format(time.RFC3339)
 	annotations["reloader/last-rotated"] = event.RotationTimestamp
 	annotations["reloader/trigger-source"] = event.TriggerSource
 
@@ -126,10 +143,89 @@ func (h *Handler) WaitFor(obj client.Object) error {
 	return h.waitForFn(obj)
 }
 
-// _waitFor is a noop for ExternalSecrets
+// _waitFor waits until the ExternalSecret refreshed after the force-sync request and is Ready.
+// obj is returned by the update requesting the refresh, so its status predates the refresh.
 func (h *Handler) _waitFor(obj client.Object) error {
-	// ExternalSecrets handler does not need to wait for anything
-	return nil
+	es, ok := obj.(*esov1.ExternalSecret)
+	if !ok {
+		return errors.New("obj isn't type ExternalSecret")
+	}
+	return h.WaitForRefresh(es, es.Status.SyncedResourceVersion)
+}
+
+// WaitForRefresh waits until the ExternalSecret synced another version than syncedBefore and is Ready.
+// ExternalSecrets that ESO does not refresh are not waited on.
+func (h *Handler) WaitForRefresh(es *esov1.ExternalSecret, syncedBefore string) error {
+	logger := log.FromContext(h.ctx)
+	if !refreshable(es) {
+		logger.V(1).Info("not waiting for ExternalSecret as its refresh policy disallows refreshing", "name", es.Name, "namespace", es.Namespace)
+		return nil
+	}
+
+	logger.V(1).Info("Waiting for ExternalSecret to sync", "name", es.Name, "namespace", es.Namespace)
+
+	ticker := time.NewTicker(syncPollEvery)
+	defer ticker.Stop()
+
+	timeout := time.After(syncTimeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for ExternalSecret %s/%s to sync", es.Namespace, es.Name)
+		case <-ticker.C:
+			current := &esov1.ExternalSecret{}
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(es), current); err != nil {
+				return fmt.Errorf("failed to get ExternalSecret: %w", err)
+			}
+			synced, err := isSynced(current, syncedBefore)
+			if err != nil {
+				return fmt.Errorf("ExternalSecret %s/%s: %w", es.Namespace, es.Name, err)
+			}
+			if synced {
+				logger.V(1).Info("ExternalSecret synced", "name", es.Name, "namespace", es.Namespace)
+				return nil
+			}
+		}
+	}
+}
+
+// refreshable mirrors ESO's shouldRefresh: once synced, ExternalSecrets with the `CreatedOnce` refresh policy,
+// or the `Periodic` one and a zero refresh interval, are not refreshed, even on a force-sync request.
+func refreshable(es *esov1.ExternalSecret) bool {
+	if es.Status.SyncedResourceVersion == "" {
+		return true
+	}
+	switch es.Spec.RefreshPolicy {
+	case esov1.RefreshPolicyCreatedOnce:
+		return false
+	case esov1.RefreshPolicyOnChange:
+		return true
+	default:
+		return es.Spec.RefreshInterval == nil || es.Spec.RefreshInterval.Duration > 0
+	}
+}
+
+// isSynced checks if the ExternalSecret synced another version than syncedBefore and is Ready.
+// ESO derives `status.syncedResourceVersion` from the annotations it synced, so a refresh requested through
+// the force-sync annotation changes it, while a refresh predating the request does not.
+// A SecretSyncedError is returned as errSyncFailed.
+func isSynced(es *esov1.ExternalSecret, syncedBefore string) (bool, error) {
+	for _, condition := range es.Status.Conditions {
+		if condition.Type != esov1.ExternalSecretReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionFalse && condition.Reason == esov1.ConditionReasonSecretSyncedError {
+			return false, fmt.Errorf("%w: %s", errSyncFailed, condition.Message)
+		}
+		if es.Status.SyncedResourceVersion == syncedBefore {
+			return false, nil
+		}
+		return condition.Status == corev1.ConditionTrue, nil
+	}
+	return false, nil
 }
 func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
 	return h.referenceFn(obj, secretIdentifier)
@@ -142,29 +238,35 @@ func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool,
 	if !ok {
 		return false, errors.New("obj isn't type ExternalSecret")
 	}
+	return SpecReferences(&es.Spec, secretIdentifier), nil
+}
+
+// SpecReferences checks if the ExternalSecret spec references the given secret identifier.
+// It is shared with ClusterExternalSecrets, which embed an ExternalSecret spec.
+func SpecReferences(spec *esov1.ExternalSecretSpec, secretIdentifier string) bool {
 	// Check Data field
-	for _, data := range es.Spec.Data {
+	for _, data := range spec.Data {
 		if data.RemoteRef.Key == secretIdentifier {
-			return true, nil
+			return true
 		}
 	}
 
 	// Check DataFrom field
-	for _, dataFrom := range es.Spec.DataFrom {
+	for _, dataFrom := range spec.DataFrom {
 		if dataFrom.Extract != nil && dataFrom.Extract.Key == secretIdentifier {
-			return true, nil
+			return true
 		}
 		// Handle RegExp matching if needed
 		if dataFrom.Find != nil {
 			if dataFrom.Find.Name != nil {
 				re := regexp.MustCompile(dataFrom.Find.Name.RegExp)
 				if re.MatchString(secretIdentifier) {
-					return true, nil
+					return true
 				}
 			}
 		}
 	}
-	return false, nil
+	return false
 }
 
 func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
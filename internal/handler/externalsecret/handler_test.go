@@ -0,0 +1,156 @@
+package externalsecret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	esov1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsSynced(t *testing.T) {
+	const syncedBefore = "1-abc"
+	ready := func(status corev1.ConditionStatus, reason string) esov1.ExternalSecretStatusCondition {
+		return esov1.ExternalSecretStatusCondition{Type: esov1.ExternalSecretReady, Status: status, Reason: reason}
+	}
+	testCases := []struct {
+		name       string
+		synced     string
+		condition  esov1.ExternalSecretStatusCondition
+		wantSynced bool
+		wantErr    bool
+	}{
+		{name: "refreshed and ready", synced: "1-def", condition: ready(corev1.ConditionTrue, esov1.ConditionReasonSecretSynced), wantSynced: true},
+		{name: "not refreshed yet", synced: syncedBefore, condition: ready(corev1.ConditionTrue, esov1.ConditionReasonSecretSynced)},
+		{name: "sync failed", synced: syncedBefore, condition: ready(corev1.ConditionFalse, esov1.ConditionReasonSecretSyncedError), wantErr: true},
+		{name: "refreshed but not ready", synced: "1-def", condition: ready(corev1.ConditionFalse, "SecretDeleted")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			es := &esov1.ExternalSecret{Status: esov1.ExternalSecretStatus{
+				SyncedResourceVersion: tc.synced,
+				Conditions:            []esov1.ExternalSecretStatusCondition{tc.condition},
+			}}
+			synced, err := isSynced(es, syncedBefore)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errSyncFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSynced, synced)
+		})
+	}
+}
+
+func newRefreshPolicyObjects() []client.Object {
+	synced := esov1.ExternalSecretStatus{SyncedResourceVersion: "1-abc"}
+	return []client.Object{
+		&esov1.ExternalSecret{ObjectMeta: metav1.ObjectMeta{Name: "periodic", Namespace: "default"}, Status: synced},
+		&esov1.ExternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: "once", Namespace: "default"},
+			Spec:       esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyCreatedOnce},
+			Status:     synced,
+		},
+		&esov1.ExternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabled", Namespace: "default"},
+			Spec:       esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyPeriodic, RefreshInterval: &metav1.Duration{}},
+			Status:     synced,
+		},
+	}
+}
+
+func TestRefreshable(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       esov1.ExternalSecretSpec
+		synced     bool
+		wantResult bool
+	}{
+		{name: "periodic", spec: esov1.ExternalSecretSpec{RefreshInterval: &metav1.Duration{Duration: time.Hour}}, synced: true, wantResult: true},
+		{name: "periodic without interval", spec: esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyPeriodic, RefreshInterval: &metav1.Duration{}}, synced: true},
+		{name: "periodic without interval not synced yet", spec: esov1.ExternalSecretSpec{RefreshInterval: &metav1.Duration{}}, wantResult: true},
+		{name: "created once", spec: esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyCreatedOnce}, synced: true},
+		{name: "created once not synced yet", spec: esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyCreatedOnce}, wantResult: true},
+		{name: "on change", spec: esov1.ExternalSecretSpec{RefreshPolicy: esov1.RefreshPolicyOnChange, RefreshInterval: &metav1.Duration{}}, synced: true, wantResult: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			es := &esov1.ExternalSecret{Spec: tc.spec}
+			if tc.synced {
+				es.Status.SyncedResourceVersion = "1-abc"
+			}
+			assert.Equal(t, tc.wantResult, refreshable(es))
+		})
+	}
+}
+
+func TestExternalSecretHandlerApply(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		respectRefreshPolicy bool
+		wantRefreshed        []string
+	}{
+		{name: "refreshes all", wantRefreshed: []string{"periodic", "once", "disabled"}},
+		{name: "respects refresh policy", respectRefreshPolicy: true, wantRefreshed: []string{"periodic"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(s))
+			require.NoError(t, esov1.AddToScheme(s))
+			c := fake.NewClientBuilder().WithScheme(s).WithObjects(newRefreshPolicyObjects()...).Build()
+			destination := v1alpha1.DestinationToWatch{
+				Type:           "ExternalSecret",
+				ExternalSecret: &v1alpha1.ExternalSecretDestination{RespectRefreshPolicy: tc.respectRefreshPolicy},
+			}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+			event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			var refreshed []string
+			for _, obj := range objs {
+				require.NoError(t, h.Apply(obj, event))
+				es := &esov1.ExternalSecret{}
+				require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(obj), es))
+				_, err := time.Parse(time.RFC3339, es.Annotations[esov1.AnnotationForceSync])
+				require.NoError(t, err)
+				refreshed = append(refreshed, es.Name)
+			}
+			assert.ElementsMatch(t, tc.wantRefreshed, refreshed)
+		})
+	}
+}
+
+func TestExternalSecretHandlerWaitForNotRefreshable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, esov1.AddToScheme(s))
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(newRefreshPolicyObjects()...).Build()
+	destination := v1alpha1.DestinationToWatch{Type: "ExternalSecret", ExternalSecret: &v1alpha1.ExternalSecretDestination{}}
+	h := (&Provider{}).NewHandler(ctx, c, destination)
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	for _, name := range []string{"once", "disabled"} {
+		es := &esov1.ExternalSecret{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, es))
+		require.NoError(t, h.Apply(es, event))
+		assert.NoError(t, h.WaitFor(es), name)
+	}
+}
@@ -0,0 +1,253 @@
+package flux
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// requestedAtAnnotation requests a reconcile, as `flux reconcile` does.
+	requestedAtAnnotation = "reconcile.fluxcd.io/requestedAt"
+	reconcileTimeout      = 10 * time.Minute
+	reconcilePollEvery    = 100 * time.Millisecond
+)
+
+// errReconcileFailed is returned by WaitFor when the object reports a failed reconcile.
+var errReconcileFailed = errors.New("reconcile failed")
+
+var kinds = map[v1alpha1.FluxKind]kruntime.GroupVersionKind{
+	v1alpha1.FluxKindKustomization: {Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"},
+	v1alpha1.FluxKindHelmRelease:   {Group: "helm.toolkit.fluxcd.io", Version: "v2", Kind: "HelmRelease"},
+}
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.Flux == nil {
+		return nil, errors.New("destination isn't type Flux")
+	}
+	gvk, ok := kinds[destination.Flux.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported Flux kind %q", destination.Flux.Kind)
+	}
+	logger := log.FromContext(h.ctx)
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := h.client.List(h.ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", gvk.Kind, err)
+	}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		isWatched, err := h.isResourceWatched(obj, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if Flux object is watched", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+			continue
+		}
+		if isWatched {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply requests a reconcile of the Flux object.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[requestedAtAnnotation] = time.Now().Format(time.RFC3339Nano)
+	annotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+	annotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+
+	obj.SetAnnotations(annotations)
+
+	if err := h.client.Update(h.ctx, obj); err != nil {
+		return fmt.Errorf("failed to update %s:%w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+	}
+	logger.V(1).Info("Requested Flux reconcile", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+	return nil
+}
+
+// isResourceWatched determines if a single Flux object matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(obj client.Object, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.Flux
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not Flux")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, obj, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, obj, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(obj, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor waits until the Flux controller handled the reconcile request and reports the object Ready.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	requestedAt := obj.GetAnnotations()[requestedAtAnnotation]
+
+	logger.V(1).Info("Waiting for Flux reconcile to complete", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	ticker := time.NewTicker(reconcilePollEvery)
+	defer ticker.Stop()
+
+	timeout := time.After(reconcileTimeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for %s %s/%s to reconcile", gvk.Kind, obj.GetNamespace(), obj.GetName())
+		case <-ticker.C:
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(gvk)
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				return fmt.Errorf("failed to get %s: %w", gvk.Kind, err)
+			}
+			done, err := isReconciled(current, requestedAt)
+			if err != nil {
+				return fmt.Errorf("%s %s/%s: %w", gvk.Kind, obj.GetNamespace(), obj.GetName(), err)
+			}
+			if done {
+				logger.V(1).Info("Flux reconcile completed successfully", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+				return nil
+			}
+		}
+	}
+}
+
+// isReconciled checks if the reconcile requested at requestedAt was handled, the same way `flux reconcile` does.
+func isReconciled(obj *unstructured.Unstructured, requestedAt string) (bool, error) {
+	handledAt, _, _ := unstructured.NestedString(obj.Object, "status", "lastHandledReconcileAt")
+	if handledAt != requestedAt {
+		return false, nil
+	}
+	observed, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if observed != obj.GetGeneration() {
+		return false, nil
+	}
+	if stalled, found := condition(obj, "Stalled"); found && stalled.Status == metav1.ConditionTrue {
+		return false, fmt.Errorf("%w: stalled: %s", errReconcileFailed, stalled.Message)
+	}
+	ready, found := condition(obj, "Ready")
+	if !found {
+		return false, nil
+	}
+	switch ready.Status {
+	case metav1.ConditionTrue:
+		return true, nil
+	case metav1.ConditionFalse:
+		return false, fmt.Errorf("%w: %s", errReconcileFailed, ready.Message)
+	}
+	return false, nil
+}
+
+// condition returns the status condition of the given type.
+func condition(obj *unstructured.Unstructured, conditionType string) (metav1.Condition, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]any)
+		if !ok || m["type"] != conditionType {
+			continue
+		}
+		status, _ := m["status"].(string)
+		message, _ := m["message"].(string)
+		return metav1.Condition{Type: conditionType, Status: metav1.ConditionStatus(status), Message: message}, true
+	}
+	return metav1.Condition{}, false
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references returns true always - as Flux objects usually reference secrets indirectly, through the manifests they render.
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	return true, nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
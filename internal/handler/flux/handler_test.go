@@ -0,0 +1,106 @@
+package flux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newObject(kind v1alpha1.FluxKind, name string, status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": "flux-system", "generation": int64(3)},
+		"status":   status,
+	}}
+	obj.SetGroupVersionKind(kinds[kind])
+	return obj
+}
+
+func TestIsReconciled(t *testing.T) {
+	const requestedAt = "2024-01-01T00:00:00Z"
+	ready := func(status string) map[string]any {
+		return map[string]any{"type": "Ready", "status": status, "message": "install failed"}
+	}
+	testCases := []struct {
+		name     string
+		status   map[string]any
+		wantDone bool
+		wantErr  bool
+	}{
+		{
+			name:     "ready",
+			status:   map[string]any{"lastHandledReconcileAt": requestedAt, "observedGeneration": int64(3), "conditions": []any{ready("True")}},
+			wantDone: true,
+		},
+		{
+			name:   "request not handled",
+			status: map[string]any{"lastHandledReconcileAt": "earlier", "observedGeneration": int64(3), "conditions": []any{ready("True")}},
+		},
+		{
+			name:   "generation not observed",
+			status: map[string]any{"lastHandledReconcileAt": requestedAt, "observedGeneration": int64(2), "conditions": []any{ready("True")}},
+		},
+		{
+			name:   "reconciling",
+			status: map[string]any{"lastHandledReconcileAt": requestedAt, "observedGeneration": int64(3), "conditions": []any{ready("Unknown")}},
+		},
+		{
+			name:    "not ready",
+			status:  map[string]any{"lastHandledReconcileAt": requestedAt, "observedGeneration": int64(3), "conditions": []any{ready("False")}},
+			wantErr: true,
+		},
+		{
+			name: "stalled",
+			status: map[string]any{"lastHandledReconcileAt": requestedAt, "observedGeneration": int64(3), "conditions": []any{
+				map[string]any{"type": "Stalled", "status": "True"}, ready("Unknown"),
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			done, err := isReconciled(newObject(v1alpha1.FluxKindHelmRelease, "app", tc.status), requestedAt)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errReconcileFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDone, done)
+		})
+	}
+}
+
+func TestFluxHandlerApply(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		newObject(v1alpha1.FluxKindKustomization, "apps", nil),
+		newObject(v1alpha1.FluxKindKustomization, "infra", nil),
+		newObject(v1alpha1.FluxKindHelmRelease, "apps", nil),
+	).Build()
+	destination := v1alpha1.DestinationToWatch{Type: "Flux", Flux: &v1alpha1.FluxDestination{Kind: v1alpha1.FluxKindKustomization, Names: []string{"apps"}}}
+	h := (&Provider{}).NewHandler(ctx, c, destination)
+	// Flux objects usually live in flux-system, not in the namespace of the rotated secret.
+	event := events.SecretRotationEvent{SecretIdentifier: "db", Namespace: "default", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	objs, err := h.Filter(&destination, event)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	require.NoError(t, h.Apply(objs[0], event))
+
+	updated := &unstructured.Unstructured{}
+	updated.SetGroupVersionKind(kinds[v1alpha1.FluxKindKustomization])
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "flux-system", Name: "apps"}, updated))
+	assert.NotEmpty(t, updated.GetAnnotations()[requestedAtAnnotation])
+	assert.Equal(t, event.RotationTimestamp, updated.GetAnnotations()["reloader.external-secrets.io/last-reloaded"])
+
+	_, err = h.Filter(&v1alpha1.DestinationToWatch{Flux: &v1alpha1.FluxDestination{Kind: "GitRepository"}}, event)
+	assert.Error(t, err)
+}
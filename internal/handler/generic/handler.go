@@ -0,0 +1,375 @@
+package generic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	"github.com/external-secrets-inc/reloader/internal/util/match"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	defaultRetryTimeout = 5 * time.Second
+	defaultMaxRetries   = 60
+)
+
+// errConditionNotMet is returned by WaitFor when the condition is still not met after all retries.
+var errConditionNotMet = errors.New("condition not met")
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+// errMatchStrategyRequired is returned for Generic destinations without a MatchStrategy, which would match every object.
+var errMatchStrategyRequired = errors.New("matchStrategy is required for Generic destinations")
+
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.Generic == nil {
+		return nil, errors.New("destination isn't type Generic")
+	}
+	if destination.MatchStrategy == nil {
+		return nil, errMatchStrategyRequired
+	}
+	gvk, err := groupVersionKind(destination.Generic)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(h.ctx)
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	var opts []client.ListOption
+	if event.Namespace != "" {
+		opts = append(opts, client.InNamespace(event.Namespace))
+	}
+	if err := h.client.List(h.ctx, list, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", gvk.Kind, err)
+	}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		isWatched, err := h.isResourceWatched(obj, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if object is watched", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+			continue
+		}
+		if isWatched {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func groupVersionKind(cfg *v1alpha1.GenericDestination) (kruntime.GroupVersionKind, error) {
+	gv, err := kruntime.ParseGroupVersion(cfg.APIVersion)
+	if err != nil {
+		return kruntime.GroupVersionKind{}, fmt.Errorf("invalid apiVersion %q: %w", cfg.APIVersion, err)
+	}
+	if cfg.Kind == "" {
+		return kruntime.GroupVersionKind{}, errors.New("kind is required")
+	}
+	return gv.WithKind(cfg.Kind), nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply runs the UpdateStrategy of the destination on the object.
+// Without an UpdateStrategy, the reloader annotations are set on the object.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return errors.New("obj isn't type Unstructured")
+	}
+	kind := u.GetKind()
+	strategy := h.destinationCache.UpdateStrategy
+	if strategy == nil {
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+		annotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+		u.SetAnnotations(annotations)
+		if err := h.client.Update(h.ctx, u); err != nil {
+			return fmt.Errorf("failed to update %s:%w", kind, err)
+		}
+		logger.V(1).Info("Annotated object", "kind", kind, "name", u.GetName(), "namespace", u.GetNamespace())
+		return nil
+	}
+
+	switch strategy.Operation {
+	case v1alpha1.UpdateStrategyOperationDelete:
+		if err := h.client.Delete(h.ctx, u); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %s:%w", kind, err)
+		}
+		logger.V(1).Info("Deleted object", "kind", kind, "name", u.GetName(), "namespace", u.GetNamespace())
+		return nil
+	case v1alpha1.UpdateStrategyOperationPatch, v1alpha1.UpdateStrategyOperationPatchStatus:
+		if err := setPatchValue(u, strategy.PatchOperationConfig, event); err != nil {
+			return err
+		}
+		if strategy.Operation == v1alpha1.UpdateStrategyOperationPatchStatus {
+			if err := h.client.Status().Update(h.ctx, u); err != nil {
+				return fmt.Errorf("failed to update %s status:%w", kind, err)
+			}
+		} else if err := h.client.Update(h.ctx, u); err != nil {
+			return fmt.Errorf("failed to update %s:%w", kind, err)
+		}
+		logger.V(1).Info("Patched object", "kind", kind, "name", u.GetName(), "namespace", u.GetNamespace(), "path", strategy.PatchOperationConfig.Path)
+		return nil
+	}
+	return fmt.Errorf("unsupported update operation %q", strategy.Operation)
+}
+
+// setPatchValue renders the template with the event and sets it at the configured path.
+// A rendered JSON value is set as is, anything else as a string.
+func setPatchValue(u *unstructured.Unstructured, cfg *v1alpha1.PatchOperationConfig, event events.SecretRotationEvent) error {
+	if cfg == nil || cfg.Path == "" {
+		return errors.New("patchOperationConfig.path is required for patch operations")
+	}
+	rendered, err := render(cfg.Template, event)
+	if err != nil {
+		return err
+	}
+	var value any = rendered
+	var decoded any
+	if err := json.Unmarshal([]byte(rendered), &decoded); err == nil {
+		value = decoded
+	}
+	fields := strings.Split(strings.TrimPrefix(cfg.Path, "."), ".")
+	if err := unstructured.SetNestedField(u.Object, value, fields...); err != nil {
+		return fmt.Errorf("failed to set %q: %w", cfg.Path, err)
+	}
+	return nil
+}
+
+func render(tpl string, data any) (string, error) {
+	t, err := template.New("value").Option("missingkey=error").Parse(tpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// isResourceWatched determines if a single object matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(obj client.Object, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.Generic
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not Generic")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, obj, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, obj, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(obj, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor runs the WaitStrategy of the destination.
+// Without a WaitStrategy, it does not wait.
+func (h *Handler) _waitFor(obj client.Object) error {
+	strategy := h.destinationCache.WaitStrategy
+	if strategy == nil {
+		return nil
+	}
+	if strategy.Time != nil {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-time.After(strategy.Time.Duration):
+		}
+	}
+	if strategy.Condition == nil {
+		return nil
+	}
+	// Deleted objects are gone: there is nothing to wait for.
+	if h.destinationCache.UpdateStrategy != nil && h.destinationCache.UpdateStrategy.Operation == v1alpha1.UpdateStrategyOperationDelete {
+		return nil
+	}
+	return h.waitForCondition(obj, strategy.Condition)
+}
+
+// waitForCondition polls the object every RetryTimeout until its status has a condition matching cfg, at most MaxRetries times.
+func (h *Handler) waitForCondition(obj client.Object, cfg *v1alpha1.WaitForCondition) error {
+	logger := log.FromContext(h.ctx)
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	retryTimeout, maxRetries := defaultRetryTimeout, int32(defaultMaxRetries)
+	if cfg.RetryTimeout != nil {
+		retryTimeout = cfg.RetryTimeout.Duration
+	}
+	if cfg.MaxRetries != nil {
+		maxRetries = *cfg.MaxRetries
+	}
+
+	logger.V(1).Info("Waiting for condition", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace(), "condition", cfg.Type)
+
+	ticker := time.NewTicker(retryTimeout)
+	defer ticker.Stop()
+
+	for retry := int32(0); retry < maxRetries; retry++ {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-ticker.C:
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(gvk)
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				return fmt.Errorf("failed to get %s: %w", gvk.Kind, err)
+			}
+			if conditionMet(current, cfg, time.Now()) {
+				logger.V(1).Info("Condition met", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace(), "condition", cfg.Type)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s %s/%s: %w: %s after %d retries", gvk.Kind, obj.GetNamespace(), obj.GetName(), errConditionNotMet, cfg.Type, maxRetries)
+}
+
+// conditionMet checks if the object has a status condition matching cfg at now.
+// Empty Status, Reason and Message match any value.
+func conditionMet(obj *unstructured.Unstructured, cfg *v1alpha1.WaitForCondition, now time.Time) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]any)
+		if !ok || m["type"] != cfg.Type {
+			continue
+		}
+		if !matchesField(m, "status", cfg.Status) || !matchesField(m, "reason", cfg.Reason) || !matchesField(m, "message", cfg.Message) {
+			return false
+		}
+		return elapsedSince(m, "lastTransitionTime", cfg.TransitionedAfter, now) && elapsedSince(m, "lastUpdateTime", cfg.UpdatedAfter, now)
+	}
+	return false
+}
+
+func matchesField(condition map[string]any, field, want string) bool {
+	if want == "" {
+		return true
+	}
+	got, _ := condition[field].(string)
+	return got == want
+}
+
+// elapsedSince checks if at least d passed since the timestamp of the condition field.
+// A nil d always passes, a missing or invalid timestamp never does.
+func elapsedSince(condition map[string]any, field string, d *metav1.Duration, now time.Time) bool {
+	if d == nil {
+		return true
+	}
+	value, _ := condition[field].(string)
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	return !now.Before(t.Add(d.Duration))
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references evaluates the MatchStrategy of the destination against the object.
+// Condition values are rendered with the secret identifier first.
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false, errors.New("obj isn't type Unstructured")
+	}
+	strategy := h.destinationCache.MatchStrategy
+	if strategy == nil {
+		return false, errMatchStrategyRequired
+	}
+	rendered := strategy.DeepCopy()
+	data := struct{ SecretIdentifier string }{SecretIdentifier: secretIdentifier}
+	for i, condition := range rendered.Conditions {
+		value, err := render(condition.Value, data)
+		if err != nil {
+			return false, err
+		}
+		rendered.Conditions[i].Value = value
+	}
+	return match.Evaluate(rendered, u.Object)
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
@@ -0,0 +1,203 @@
+package generic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kruntime "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var serviceGVK = kruntime.GroupVersionKind{Group: "serving.knative.dev", Version: "v1", Kind: "Service"}
+
+func newService(name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": "default"},
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"containers": []any{map[string]any{"envFrom": []any{map[string]any{"secretRef": map[string]any{"name": "db"}}}}},
+		}}},
+	}}
+	obj.SetGroupVersionKind(serviceGVK)
+	return obj
+}
+
+func newDestination(update *v1alpha1.UpdateStrategy) v1alpha1.DestinationToWatch {
+	return v1alpha1.DestinationToWatch{
+		Type:           "Generic",
+		Generic:        &v1alpha1.GenericDestination{APIVersion: "serving.knative.dev/v1", Kind: "Service", Names: []string{"api"}},
+		UpdateStrategy: update,
+		MatchStrategy: &v1alpha1.MatchStrategy{Path: ".spec.template.spec.containers", Conditions: []v1alpha1.Condition{
+			{Operation: v1alpha1.ConditionOperationContains, Value: `"name":"{{ .SecretIdentifier }}"`},
+		}},
+	}
+}
+
+func TestConditionMet(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 10, 0, 0, time.UTC)
+	ready := map[string]any{"type": "Ready", "status": "True", "reason": "Done", "lastTransitionTime": "2024-01-01T00:05:00Z"}
+	duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+	testCases := []struct {
+		name       string
+		conditions []any
+		cfg        v1alpha1.WaitForCondition
+		want       bool
+	}{
+		{name: "matches type and status", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", Status: "True"}, want: true},
+		{name: "empty status matches any", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready"}, want: true},
+		{name: "wrong status", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", Status: "False"}},
+		{name: "wrong reason", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", Reason: "Pending"}},
+		{name: "missing condition", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Synced"}},
+		{name: "transitioned long enough ago", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", TransitionedAfter: duration(5 * time.Minute)}, want: true},
+		{name: "transitioned too recently", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", TransitionedAfter: duration(6 * time.Minute)}},
+		{name: "missing update time", conditions: []any{ready}, cfg: v1alpha1.WaitForCondition{Type: "Ready", UpdatedAfter: duration(time.Second)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"conditions": tc.conditions}}}
+			assert.Equal(t, tc.want, conditionMet(obj, &tc.cfg, now))
+		})
+	}
+}
+
+func TestGenericHandlerReferences(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy *v1alpha1.MatchStrategy
+		want     bool
+		wantErr  bool
+	}{
+		{name: "no strategy", wantErr: true},
+		{
+			name: "templated value matches",
+			strategy: &v1alpha1.MatchStrategy{Path: ".spec.template.spec.containers", Conditions: []v1alpha1.Condition{
+				{Operation: v1alpha1.ConditionOperationContains, Value: `"name":"{{ .SecretIdentifier }}"`},
+			}},
+			want: true,
+		},
+		{
+			name: "value does not match",
+			strategy: &v1alpha1.MatchStrategy{Path: ".metadata.name", Conditions: []v1alpha1.Condition{
+				{Operation: v1alpha1.ConditionOperationEqual, Value: "web"},
+			}},
+		},
+		{
+			name: "invalid template",
+			strategy: &v1alpha1.MatchStrategy{Path: ".metadata.name", Conditions: []v1alpha1.Condition{
+				{Operation: v1alpha1.ConditionOperationEqual, Value: "{{ .Unknown }}"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			destination := newDestination(nil)
+			destination.MatchStrategy = tc.strategy
+			h := (&Provider{}).NewHandler(context.Background(), fake.NewClientBuilder().Build(), destination)
+			got, err := h.References(newService("api"), "db")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGenericHandlerFilterWithoutMatchStrategy(t *testing.T) {
+	destination := newDestination(&v1alpha1.UpdateStrategy{Operation: v1alpha1.UpdateStrategyOperationDelete})
+	destination.MatchStrategy = nil
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newService("api")).Build()
+	h := (&Provider{}).NewHandler(context.Background(), c, destination)
+
+	_, err := h.Filter(&destination, events.SecretRotationEvent{SecretIdentifier: "db"})
+	assert.ErrorIs(t, err, errMatchStrategyRequired)
+}
+
+func TestGenericHandlerApply(t *testing.T) {
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+	testCases := []struct {
+		name    string
+		update  *v1alpha1.UpdateStrategy
+		check   func(t *testing.T, obj *unstructured.Unstructured, err error)
+		wantErr bool
+	}{
+		{
+			name: "annotates by default",
+			check: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, event.RotationTimestamp, obj.GetAnnotations()["reloader.external-secrets.io/last-reloaded"])
+			},
+		},
+		{
+			name: "patches string value",
+			update: &v1alpha1.UpdateStrategy{Operation: v1alpha1.UpdateStrategyOperationPatch, PatchOperationConfig: &v1alpha1.PatchOperationConfig{
+				Path: ".spec.template.metadata.annotations.rotated", Template: "{{ .SecretIdentifier }}@{{ .RotationTimestamp }}",
+			}},
+			check: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				value, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "metadata", "annotations", "rotated")
+				assert.Equal(t, "db@2024-01-01T00:00:00Z", value)
+			},
+		},
+		{
+			name: "patches JSON value",
+			update: &v1alpha1.UpdateStrategy{Operation: v1alpha1.UpdateStrategyOperationPatch, PatchOperationConfig: &v1alpha1.PatchOperationConfig{
+				Path: ".spec.restart", Template: `{"source": "{{ .TriggerSource }}"}`,
+			}},
+			check: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				value, _, _ := unstructured.NestedString(obj.Object, "spec", "restart", "source")
+				assert.Equal(t, "test", value)
+			},
+		},
+		{
+			name:    "patch without path",
+			update:  &v1alpha1.UpdateStrategy{Operation: v1alpha1.UpdateStrategyOperationPatch},
+			wantErr: true,
+		},
+		{
+			name:   "deletes",
+			update: &v1alpha1.UpdateStrategy{Operation: v1alpha1.UpdateStrategyOperationDelete},
+			check: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				assert.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newService("api"), newService("web")).Build()
+			destination := newDestination(tc.update)
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 1)
+			err = h.Apply(objs[0], event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			updated := &unstructured.Unstructured{}
+			updated.SetGroupVersionKind(serviceGVK)
+			err = c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "api"}, updated)
+			tc.check(t, updated, err)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package httpcallback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"text/template"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/match"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	defaultContentType = "application/json"
+	defaultTimeout     = 10 * time.Second
+	defaultMaxAttempts = 3
+	defaultBackoff     = time.Second
+)
+
+// callbackEvent is the JSON representation of an event, used as default body.
+type callbackEvent struct {
+	SecretIdentifier  string `json:"secretIdentifier"`
+	RotationTimestamp string `json:"rotationTimestamp"`
+	TriggerSource     string `json:"triggerSource"`
+	Namespace         string `json:"namespace,omitempty"`
+}
+
+// errNotRetryable wraps responses that must not be retried.
+var errNotRetryable = errors.New("not retryable")
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+// Filter returns a single object holding the event, as there is no Kubernetes object to list.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	if destination.HttpCallback == nil {
+		return nil, errors.New("destination isn't type HttpCallback")
+	}
+	u, err := url.Parse(destination.HttpCallback.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	obj := &unstructured.Unstructured{Object: match.EventFields(event)}
+	obj.SetKind(schema.HTTP_CALLBACK)
+	obj.SetName(u.Host)
+	return []client.Object{obj}, nil
+}
+
+func toCallbackEvent(event events.SecretRotationEvent) callbackEvent {
+	return callbackEvent{
+		SecretIdentifier:  event.SecretIdentifier,
+		RotationTimestamp: event.RotationTimestamp,
+		TriggerSource:     event.TriggerSource,
+		Namespace:         event.Namespace,
+	}
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply posts the event to the configured URL, retrying on network errors, 429 and 5xx responses.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	cfg := h.destinationCache.HttpCallback
+	if cfg == nil {
+		return errors.New("destination isn't type HttpCallback")
+	}
+	body, err := renderBody(cfg.BodyTemplate, event)
+	if err != nil {
+		return err
+	}
+	headers, err := h.headers(cfg)
+	if err != nil {
+		return err
+	}
+	httpClient, err := h.httpClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	maxAttempts, backoff := int32(defaultMaxAttempts), defaultBackoff
+	if cfg.Retry != nil {
+		if cfg.Retry.MaxAttempts > 0 {
+			maxAttempts = cfg.Retry.MaxAttempts
+		}
+		if cfg.Retry.Backoff != nil {
+			backoff = cfg.Retry.Backoff.Duration
+		}
+	}
+	for attempt := int32(1); ; attempt++ {
+		err = post(h.ctx, httpClient, cfg.URL, headers, body)
+		if err == nil {
+			logger.V(1).Info("Posted HttpCallback", "host", obj.GetName(), "attempt", attempt)
+			return nil
+		}
+		if errors.Is(err, errNotRetryable) || attempt >= maxAttempts {
+			return fmt.Errorf("failed to post HttpCallback after %d attempts: %w", attempt, err)
+		}
+		logger.V(1).Info("Retrying HttpCallback", "host", obj.GetName(), "attempt", attempt, "error", err.Error())
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func post(ctx context.Context, httpClient *http.Client, url string, headers http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: invalid request: %w", errNotRetryable, err)
+	}
+	req.Header = headers.Clone()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return fmt.Errorf("%w: unexpected status %d", errNotRetryable, resp.StatusCode)
+}
+
+// renderBody renders the body template with the event, or encodes the event as JSON if tpl is empty.
+func renderBody(tpl string, event events.SecretRotationEvent) ([]byte, error) {
+	if tpl == "" {
+		b, err := json.Marshal(toCallbackEvent(event))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal event: %w", err)
+		}
+		return b, nil
+	}
+	t, err := template.New("body").Option("missingkey=error").Parse(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, event); err != nil {
+		return nil, fmt.Errorf("failed to render body template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// headers resolves the configured headers.
+func (h *Handler) headers(cfg *v1alpha1.HttpCallbackDestination) (http.Header, error) {
+	headers := http.Header{}
+	contentType := defaultContentType
+	if cfg.ContentType != "" {
+		contentType = cfg.ContentType
+	}
+	headers.Set("Content-Type", contentType)
+	for _, header := range cfg.Headers {
+		value := header.Value
+		if header.ValueFrom != nil {
+			v, err := resolvers.SecretKeyRef(h.ctx, h.client, header.ValueFrom)
+			if err != nil {
+				return nil, fmt.Errorf("could not get header %s: %w", header.Name, err)
+			}
+			value = v
+		}
+		headers.Set(header.Name, value)
+	}
+	return headers, nil
+}
+
+func (h *Handler) httpClient(cfg *v1alpha1.HttpCallbackDestination) (*http.Client, error) {
+	timeout := defaultTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.Duration
+	}
+	tlsConfig, err := tlsconfig.Build(h.ctx, h.client, cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor is a noop for HttpCallbacks
+func (h *Handler) _waitFor(obj client.Object) error {
+	return nil
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references evaluates the MatchStrategy of the destination against the event.
+// Without a MatchStrategy, every event matches.
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false, errors.New("obj isn't type HttpCallback")
+	}
+	return match.Evaluate(h.destinationCache.MatchStrategy, u.Object)
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
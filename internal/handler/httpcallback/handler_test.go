@@ -0,0 +1,149 @@
+package httpcallback
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHttpCallbackHandler(t *testing.T) {
+	event := events.SecretRotationEvent{SecretIdentifier: "prod/db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "AwsSqs", Namespace: "payments"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "callback", Namespace: "reloader"},
+		Data:       map[string][]byte{"token": []byte("Bearer s3cr3t")},
+	}
+
+	testCases := []struct {
+		name         string
+		statuses     []int
+		bodyTemplate string
+		wantBody     string
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "default body",
+			statuses:     []int{http.StatusNoContent},
+			wantBody:     `{"secretIdentifier":"prod/db","rotationTimestamp":"2024-01-01T00:00:00Z","triggerSource":"AwsSqs","namespace":"payments"}`,
+			wantAttempts: 1,
+		},
+		{
+			name:         "templated body",
+			statuses:     []int{http.StatusOK},
+			bodyTemplate: `{"text": "{{ .SecretIdentifier }} rotated by {{ .TriggerSource }}"}`,
+			wantBody:     `{"text": "prod/db rotated by AwsSqs"}`,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries server errors",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusAccepted},
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry client errors",
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "invalid template",
+			bodyTemplate: "{{ .Missing }}",
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			var body, auth string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				b, _ := io.ReadAll(r.Body)
+				body, auth = string(b), r.Header.Get("Authorization")
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer server.Close()
+
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "HttpCallback", HttpCallback: &v1alpha1.HttpCallbackDestination{
+				URL:          server.URL,
+				BodyTemplate: tc.bodyTemplate,
+				Headers:      []v1alpha1.HttpCallbackHeader{{Name: "Authorization", ValueFrom: &v1alpha1.SecretKeySelector{Name: "callback", Namespace: "reloader", Key: "token"}}},
+				TLS:          &v1alpha1.TLSConfig{InsecureSkipVerify: true},
+				Retry:        &v1alpha1.HttpCallbackRetry{Backoff: &metav1.Duration{Duration: time.Millisecond}},
+			}}
+			h := (&Provider{}).NewHandler(context.Background(), c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 1)
+			err = h.Apply(objs[0], event)
+			assert.Equal(t, tc.wantAttempts, attempts.Load())
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Bearer s3cr3t", auth)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, body)
+			}
+		})
+	}
+}
+
+func TestHttpCallbackReferences(t *testing.T) {
+	event := events.SecretRotationEvent{SecretIdentifier: "prod/db", TriggerSource: "AwsSqs"}
+	testCases := []struct {
+		name     string
+		strategy *v1alpha1.MatchStrategy
+		want     bool
+	}{
+		{name: "no strategy", want: true},
+		{
+			name:     "matching identifier",
+			strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{{Operation: v1alpha1.ConditionOperationContains, Value: "prod/"}}},
+			want:     true,
+		},
+		{
+			name:     "other trigger source",
+			strategy: &v1alpha1.MatchStrategy{Path: ".triggerSource", Conditions: []v1alpha1.Condition{{Operation: v1alpha1.ConditionOperationEqual, Value: "Webhook"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			destination := v1alpha1.DestinationToWatch{
+				Type:          "HttpCallback",
+				HttpCallback:  &v1alpha1.HttpCallbackDestination{URL: "https://hooks.example.com/rotate"},
+				MatchStrategy: tc.strategy,
+			}
+			h := (&Provider{}).NewHandler(context.Background(), nil, destination)
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			assert.Equal(t, "hooks.example.com", objs[0].GetName())
+			got, err := h.References(objs[0], event.SecretIdentifier)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// originalNameAnnotation keeps the name a Job was first created with across recreations.
+	originalNameAnnotation = "reloader.external-secrets.io/original-name"
+	// jobTemplateLabel is set on the Jobs created by JobTemplate destinations.
+	jobTemplateLabel = "reloader.external-secrets.io/job-template"
+)
+
+// generatedLabels are set by the Job controller from the Job uid and name.
+// They are removed so the recreated Job gets its own.
+var generatedLabels = []string{
+	"controller-uid",
+	"job-name",
+	batchv1.ControllerUidLabel,
+	batchv1.JobNameLabel,
+}
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+// Filter returns the watched Jobs that are still running, not controlled by a CronJob and not created by a JobTemplate.
+// Without Recreate, it returns nothing.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.Job == nil {
+		return nil, errors.New("destination isn't type Job")
+	}
+	logger := log.FromContext(h.ctx)
+	if !destination.Job.Recreate {
+		logger.V(1).Info("skipping Job destination as recreate is not set")
+		return objs, nil
+	}
+	var jobs batchv1.JobList
+	var opts []client.ListOption
+	if event.Namespace != "" {
+		opts = append(opts, client.InNamespace(event.Namespace))
+	}
+	if err := h.client.List(h.ctx, &jobs, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list Jobs:%w", err)
+	}
+	for key, job := range jobs.Items {
+		if _, hook := job.Labels[jobTemplateLabel]; hook || isJobFinished(&job) || isControlledByCronJob(&job) {
+			continue
+		}
+		isWatched, err := h.isResourceWatched(job, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if Job is watched", "name", job.Name, "namespace", job.Namespace)
+			continue
+		}
+		if isWatched {
+			objs = append(objs, &jobs.Items[key])
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply replaces the Job with a copy under a new name, as the pod template of a Job can't be updated.
+// The copy is created before the Job is deleted, so a failed create leaves the Job running.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return errors.New("obj isn't type Job")
+	}
+	recreated := recreateJob(job, event)
+	if err := h.client.Create(h.ctx, recreated); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create Job:%w", err)
+	}
+	if err := h.client.Delete(h.ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete Job:%w", err)
+	}
+	logger.V(1).Info("Recreated Job", "name", job.GetName(), "namespace", job.GetNamespace(), "newName", recreated.GetName())
+	return nil
+}
+
+// recreateJob builds a new Job from the spec of job, with the reloader annotations on its pod template.
+func recreateJob(job *batchv1.Job, event events.SecretRotationEvent) *batchv1.Job {
+	originalName := originalName(job)
+	annotations := maps.Clone(job.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[originalNameAnnotation] = originalName
+
+	spec := job.Spec.DeepCopy()
+	jobLabels := maps.Clone(job.Labels)
+	if spec.ManualSelector == nil || !*spec.ManualSelector {
+		spec.Selector = nil
+		for _, label := range generatedLabels {
+			delete(jobLabels, label)
+			delete(spec.Template.Labels, label)
+		}
+	}
+	tplAnnotations := spec.Template.GetAnnotations()
+	if tplAnnotations == nil {
+		tplAnnotations = make(map[string]string)
+	}
+	tplAnnotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+	tplAnnotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+	spec.Template.SetAnnotations(tplAnnotations)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.SuffixedName(originalName, event),
+			Namespace:       job.Namespace,
+			Labels:          jobLabels,
+			Annotations:     annotations,
+			OwnerReferences: job.OwnerReferences,
+		},
+		Spec: *spec,
+	}
+}
+
+func originalName(job *batchv1.Job) string {
+	if name := job.Annotations[originalNameAnnotation]; name != "" {
+		return name
+	}
+	return job.Name
+}
+
+// isJobFinished checks if the Job completed or failed.
+func isJobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// isControlledByCronJob checks if the Job was created by a CronJob, which would not know about a recreated copy.
+func isControlledByCronJob(job *batchv1.Job) bool {
+	owner := metav1.GetControllerOf(job)
+	return owner != nil && owner.Kind == "CronJob"
+}
+
+// isResourceWatched determines if a single Job matches any of the SecretsToWatch criteria.
+// Names are matched against the name the Job was originally created with.
+func (h *Handler) isResourceWatched(job batchv1.Job, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.Job
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not Job")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, &job, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, &job, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	_, nameMatch := nameSet[originalName(&job)]
+	nameMatch = nameMatch || len(nameSet) == 0
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor does not wait: Jobs may run for an unbounded time.
+func (h *Handler) _waitFor(obj client.Object) error {
+	return nil
+}
+
+func (h *Handler) References(obj client.Object, identifier string) (bool, error) {
+	return h.referenceFn(obj, identifier)
+}
+
+// _references checks if the pod template of the Job references the given secret identifier.
+// It is the default References implementation
+func (h *Handler) _references(obj client.Object, identifier string) (bool, error) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return false, errors.New("obj isn't type Job")
+	}
+	return util.PodSpecReferences(&job.Spec.Template.Spec, identifier), nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
@@ -0,0 +1,117 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestJobHandler(t *testing.T) {
+	newJob := func(name string, finished bool) *batchv1.Job {
+		generated := map[string]string{batchv1.ControllerUidLabel: "uid-" + name, batchv1.JobNameLabel: name, "app": "migrate"}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: generated},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{batchv1.ControllerUidLabel: "uid-" + name}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: generated},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name:    "migrate",
+						EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db"}}}},
+					}}},
+				},
+			},
+		}
+		if finished {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		}
+		return job
+	}
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newJob("migrate", false), newJob("seed", true)).Build()
+	destination := v1alpha1.DestinationToWatch{Type: "Job", Job: &v1alpha1.JobDestination{Names: []string{"migrate", "seed"}, Recreate: true}}
+	h := (&Provider{}).NewHandler(ctx, c, destination)
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	objs, err := h.Filter(&destination, event)
+	require.NoError(t, err)
+	require.Len(t, objs, 1, "finished Jobs are skipped")
+	referenced, err := h.References(objs[0], "db")
+	require.NoError(t, err)
+	require.True(t, referenced)
+	require.NoError(t, h.Apply(objs[0], event))
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(ctx, &jobs))
+	require.Len(t, jobs.Items, 2)
+	var recreated *batchv1.Job
+	for i := range jobs.Items {
+		if jobs.Items[i].Name != "seed" {
+			recreated = &jobs.Items[i]
+		}
+	}
+	require.NotNil(t, recreated)
+	assert.NotEqual(t, "migrate", recreated.Name)
+	assert.Equal(t, "migrate", recreated.Annotations[originalNameAnnotation])
+	assert.Nil(t, recreated.Spec.Selector)
+	assert.Equal(t, map[string]string{"app": "migrate"}, recreated.Labels)
+	assert.Equal(t, map[string]string{"app": "migrate"}, recreated.Spec.Template.Labels)
+	assert.Equal(t, event.RotationTimestamp, recreated.Spec.Template.Annotations["reloader.external-secrets.io/last-reloaded"])
+
+	// The recreated Job is still matched by its original name.
+	objs, err = h.Filter(&destination, event)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, recreated.Name, objs[0].GetName())
+	assert.Equal(t, recreated.Name, recreateJob(objs[0].(*batchv1.Job), event).Name, "the same event keeps the same name")
+	var missing batchv1.Job
+	assert.Error(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "migrate"}, &missing))
+}
+
+func TestJobHandlerFilter(t *testing.T) {
+	running := func(name string, owner *metav1.OwnerReference) *batchv1.Job {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{}}}
+		if owner != nil {
+			job.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return job
+	}
+	cronJob := &metav1.OwnerReference{APIVersion: "batch/v1", Kind: "CronJob", Name: "nightly", UID: "uid-nightly", Controller: ptr.To(true)}
+	hook := running("flush-1a2b3c4d5e", nil)
+	hook.Labels[jobTemplateLabel] = "flush"
+	testCases := []struct {
+		name      string
+		recreate  bool
+		wantNames []string
+	}{
+		{name: "recreate not set"},
+		{name: "skips Jobs of CronJobs and JobTemplates", recreate: true, wantNames: []string{"migrate"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(running("migrate", nil), running("nightly-28000000", cronJob), hook).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "Job", Job: &v1alpha1.JobDestination{Recreate: tc.recreate}}
+			h := (&Provider{}).NewHandler(context.Background(), c, destination)
+
+			objs, err := h.Filter(&destination, events.SecretRotationEvent{SecretIdentifier: "db"})
+			require.NoError(t, err)
+			var names []string
+			for _, obj := range objs {
+				names = append(names, obj.GetName())
+			}
+			assert.Equal(t, tc.wantNames, names)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package jobtemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	"github.com/external-secrets-inc/reloader/internal/util/match"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// jobTemplateLabel holds the destination name on created Jobs, to find them when pruning the history.
+	jobTemplateLabel    = "reloader.external-secrets.io/job-template"
+	defaultHistoryLimit = 3
+	defaultTimeout      = 10 * time.Minute
+	jobPollEvery        = 100 * time.Millisecond
+)
+
+// errJobFailed is returned by WaitFor when the Job failed.
+var errJobFailed = errors.New("job failed")
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+// Filter returns the Job to create for the event, or nothing if the MatchStrategy does not match the event.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	cfg := destination.JobTemplate
+	if cfg == nil {
+		return nil, errors.New("destination isn't type JobTemplate")
+	}
+	matches, err := match.Evaluate(destination.MatchStrategy, match.EventFields(event))
+	if err != nil || !matches {
+		return nil, err
+	}
+	tpl, err := h.template(cfg)
+	if err != nil {
+		return nil, err
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		allowed, err := h.isNamespaceAllowed(cfg, event.Namespace)
+		if err != nil || !allowed {
+			return nil, err
+		}
+		namespace = event.Namespace
+	}
+	return []client.Object{newJob(cfg, tpl, namespace, event)}, nil
+}
+
+// isNamespaceAllowed checks if the event namespace matches any of the NamespaceSelectors.
+// The event namespace is set by the sender, so without selectors no event namespace is allowed.
+func (h *Handler) isNamespaceAllowed(cfg *v1alpha1.JobTemplateDestination, namespace string) (bool, error) {
+	if len(cfg.NamespaceSelectors) == 0 {
+		return false, errors.New("JobTemplate requires one of namespace and namespaceSelectors")
+	}
+	if namespace == "" {
+		return false, errors.New("JobTemplate namespace is required for events without a namespace")
+	}
+	selectors := make([]labels.Selector, 0, len(cfg.NamespaceSelectors))
+	for _, nsSelector := range cfg.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		selectors = append(selectors, selector)
+	}
+	ns := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}}
+	allowed, err := util.MatchesAnyNamespaceSelector(h.ctx, ns, selectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	if !allowed {
+		log.FromContext(h.ctx).Info("ignoring event from a namespace not matching the JobTemplate namespaceSelectors", "name", cfg.Name, "namespace", namespace)
+	}
+	return allowed, nil
+}
+
+// template returns the inline template, or reads the referenced one.
+func (h *Handler) template(cfg *v1alpha1.JobTemplateDestination) (*v1alpha1.JobTemplate, error) {
+	if (cfg.Template == nil) == (cfg.TemplateRef == nil) {
+		return nil, errors.New("exactly one of template and templateRef must be set")
+	}
+	if cfg.Template != nil {
+		return cfg.Template, nil
+	}
+	var cm corev1.ConfigMap
+	ref := cfg.TemplateRef
+	if err := h.client.Get(h.ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, &cm); err != nil {
+		return nil, fmt.Errorf("failed to get template ConfigMap: %w", err)
+	}
+	data, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("template ConfigMap %s/%s has no key %q", ref.Namespace, ref.Name, ref.Key)
+	}
+	tpl := &v1alpha1.JobTemplate{}
+	if err := yaml.UnmarshalStrict([]byte(data), tpl); err != nil {
+		return nil, fmt.Errorf("invalid template in ConfigMap %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return tpl, nil
+}
+
+// newJob builds the Job for the event from the template, with the event fields injected.
+func newJob(cfg *v1alpha1.JobTemplateDestination, tpl *v1alpha1.JobTemplate, namespace string, event events.SecretRotationEvent) *batchv1.Job {
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        util.SuffixedName(cfg.Name, event),
+			Namespace:   namespace,
+			Labels:      maps.Clone(tpl.Labels),
+			Annotations: maps.Clone(tpl.Annotations),
+		},
+		Spec: *tpl.Spec.DeepCopy(),
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[jobTemplateLabel] = cfg.Name
+
+	switch cfg.InjectAs {
+	case v1alpha1.JobTemplateInjectionAnnotations:
+		annotations := eventAnnotations(event)
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		maps.Copy(job.Annotations, annotations)
+		if job.Spec.Template.Annotations == nil {
+			job.Spec.Template.Annotations = map[string]string{}
+		}
+		maps.Copy(job.Spec.Template.Annotations, annotations)
+	default:
+		env := eventEnv(event)
+		podSpec := &job.Spec.Template.Spec
+		for i := range podSpec.InitContainers {
+			podSpec.InitContainers[i].Env = append(podSpec.InitContainers[i].Env, env...)
+		}
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env...)
+		}
+	}
+	return job
+}
+
+func eventEnv(event events.SecretRotationEvent) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "RELOADER_SECRET_IDENTIFIER", Value: event.SecretIdentifier},
+		{Name: "RELOADER_ROTATION_TIMESTAMP", Value: event.RotationTimestamp},
+		{Name: "RELOADER_TRIGGER_SOURCE", Value: event.TriggerSource},
+		{Name: "RELOADER_NAMESPACE", Value: event.Namespace},
+	}
+}
+
+func eventAnnotations(event events.SecretRotationEvent) map[string]string {
+	return map[string]string{
+		"reloader.external-secrets.io/secret-identifier":  event.SecretIdentifier,
+		"reloader.external-secrets.io/rotation-timestamp": event.RotationTimestamp,
+		"reloader.external-secrets.io/trigger-source":     event.TriggerSource,
+		"reloader.external-secrets.io/namespace":          event.Namespace,
+	}
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply creates the Job and prunes the finished Jobs beyond the history limit.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return errors.New("obj isn't type Job")
+	}
+	if err := h.client.Create(h.ctx, job); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create Job:%w", err)
+		}
+		logger.V(1).Info("Job already exists for event", "name", job.GetName(), "namespace", job.GetNamespace())
+	} else {
+		logger.V(1).Info("Created Job", "name", job.GetName(), "namespace", job.GetNamespace())
+	}
+	if err := h.pruneHistory(job); err != nil {
+		// The Job was created: a failed cleanup should not fail the event.
+		logger.Error(err, "failed to prune Job history", "namespace", job.GetNamespace(), "jobTemplate", job.Labels[jobTemplateLabel])
+	}
+	return nil
+}
+
+// pruneHistory deletes the oldest finished Jobs of the destination, keeping at most HistoryLimit of them.
+func (h *Handler) pruneHistory(job *batchv1.Job) error {
+	limit := int32(defaultHistoryLimit)
+	if cfg := h.destinationCache.JobTemplate; cfg != nil && cfg.HistoryLimit != nil {
+		limit = *cfg.HistoryLimit
+	}
+	var jobs batchv1.JobList
+	if err := h.client.List(h.ctx, &jobs, client.InNamespace(job.Namespace), client.MatchingLabels{jobTemplateLabel: job.Labels[jobTemplateLabel]}); err != nil {
+		return fmt.Errorf("failed to list Jobs: %w", err)
+	}
+	finished := make([]*batchv1.Job, 0, len(jobs.Items))
+	for i := range jobs.Items {
+		if done, _ := jobResult(&jobs.Items[i]); done {
+			finished = append(finished, &jobs.Items[i])
+		}
+	}
+	if int32(len(finished)) <= limit {
+		return nil
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].CreationTimestamp.After(finished[j].CreationTimestamp.Time)
+	})
+	for _, old := range finished[limit:] {
+		if err := h.client.Delete(h.ctx, old, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Job %s: %w", old.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor waits for the Job to complete, failing as soon as the Job fails.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	timeout := defaultTimeout
+	if cfg := h.destinationCache.JobTemplate; cfg != nil && cfg.Timeout != nil {
+		timeout = cfg.Timeout.Duration
+	}
+
+	logger.V(1).Info("Waiting for Job to complete", "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	ticker := time.NewTicker(jobPollEvery)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("timeout waiting for job %s/%s to complete", obj.GetNamespace(), obj.GetName())
+		case <-ticker.C:
+			current := &batchv1.Job{}
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				return fmt.Errorf("failed to get job: %w", err)
+			}
+			done, err := jobResult(current)
+			if !done {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("job %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			logger.V(1).Info("Job completed successfully", "name", obj.GetName(), "namespace", obj.GetNamespace())
+			return nil
+		}
+	}
+}
+
+// jobResult returns whether the Job finished, and an error if it failed.
+func jobResult(job *batchv1.Job) (bool, error) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return true, fmt.Errorf("%w: %s", errJobFailed, condition.Message)
+		}
+	}
+	return false, nil
+}
+
+func (h *Handler) References(obj client.Object, secretIdentifier string) (bool, error) {
+	return h.referenceFn(obj, secretIdentifier)
+}
+
+// _references returns true always - as the MatchStrategy is already evaluated by Filter.
+func (h *Handler) _references(obj client.Object, secretIdentifier string) (bool, error) {
+	return true, nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
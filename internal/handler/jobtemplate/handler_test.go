@@ -0,0 +1,167 @@
+package jobtemplate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const templateYAML = `labels:
+  app: flush
+spec:
+  backoffLimit: 1
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: flush
+        image: flush:latest
+`
+
+func TestJobTemplateFilter(t *testing.T) {
+	inline := &v1alpha1.JobTemplate{
+		Labels: map[string]string{"app": "flush"},
+		Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers:    []corev1.Container{{Name: "flush", Image: "flush:latest"}},
+		}}},
+	}
+	templates := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "reloader"},
+		Data:       map[string]string{"flush": templateYAML, "invalid": "spec: [", "unknown": "spek: {}"},
+	}
+	ref := func(key string) *v1alpha1.ConfigMapKeySelector {
+		return &v1alpha1.ConfigMapKeySelector{Name: "templates", Namespace: "reloader", Key: key}
+	}
+	payments := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments", Labels: map[string]string{"hooks": "enabled"}}}
+	selectors := func(value string) []metav1.LabelSelector {
+		return []metav1.LabelSelector{{MatchLabels: map[string]string{"hooks": value}}}
+	}
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test", Namespace: "payments"}
+
+	testCases := []struct {
+		name            string
+		destination     v1alpha1.JobTemplateDestination
+		matchStrategy   *v1alpha1.MatchStrategy
+		wantJobs        int
+		wantEnv         bool
+		wantAnnotations bool
+		wantErr         bool
+	}{
+		{name: "inline template with env", destination: v1alpha1.JobTemplateDestination{Name: "flush", Namespace: "payments", Template: inline}, wantJobs: 1, wantEnv: true},
+		{name: "referenced template with annotations", destination: v1alpha1.JobTemplateDestination{Name: "flush", Namespace: "payments", TemplateRef: ref("flush"), InjectAs: v1alpha1.JobTemplateInjectionAnnotations}, wantJobs: 1, wantAnnotations: true},
+		{name: "selected event namespace", destination: v1alpha1.JobTemplateDestination{Name: "flush", NamespaceSelectors: selectors("enabled"), Template: inline}, wantJobs: 1, wantEnv: true},
+		{name: "event namespace not selected", destination: v1alpha1.JobTemplateDestination{Name: "flush", NamespaceSelectors: selectors("disabled"), Template: inline}},
+		{name: "neither namespace nor selectors", destination: v1alpha1.JobTemplateDestination{Name: "flush", Template: inline}, wantErr: true},
+		{
+			name:          "event not matched",
+			destination:   v1alpha1.JobTemplateDestination{Name: "flush", Namespace: "payments", Template: inline},
+			matchStrategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{{Operation: v1alpha1.ConditionOperationEqual, Value: "api"}}},
+		},
+		{name: "no template", destination: v1alpha1.JobTemplateDestination{Name: "flush"}, wantErr: true},
+		{name: "both templates", destination: v1alpha1.JobTemplateDestination{Name: "flush", Template: inline, TemplateRef: ref("flush")}, wantErr: true},
+		{name: "invalid referenced template", destination: v1alpha1.JobTemplateDestination{Name: "flush", TemplateRef: ref("invalid")}, wantErr: true},
+		{name: "unknown field in referenced template", destination: v1alpha1.JobTemplateDestination{Name: "flush", TemplateRef: ref("unknown")}, wantErr: true},
+		{name: "missing key", destination: v1alpha1.JobTemplateDestination{Name: "flush", TemplateRef: ref("missing")}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(templates, payments).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "JobTemplate", JobTemplate: &tc.destination, MatchStrategy: tc.matchStrategy}
+			h := (&Provider{}).NewHandler(context.Background(), c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, objs, tc.wantJobs)
+			if tc.wantJobs == 0 {
+				return
+			}
+			job := objs[0].(*batchv1.Job)
+			assert.Equal(t, "payments", job.Namespace)
+			assert.Equal(t, "flush", job.Labels["app"])
+			assert.Equal(t, "flush", job.Labels[jobTemplateLabel])
+			env := job.Spec.Template.Spec.Containers[0].Env
+			if tc.wantEnv {
+				assert.Contains(t, env, corev1.EnvVar{Name: "RELOADER_SECRET_IDENTIFIER", Value: "db"})
+			} else {
+				assert.Empty(t, env)
+			}
+			if tc.wantAnnotations {
+				assert.Equal(t, "db", job.Spec.Template.Annotations["reloader.external-secrets.io/secret-identifier"])
+				assert.Equal(t, "test", job.Annotations["reloader.external-secrets.io/trigger-source"])
+			}
+		})
+	}
+}
+
+func TestJobTemplateApply(t *testing.T) {
+	finishedJob := func(name string, age time.Duration) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "payments",
+				Labels:            map[string]string{jobTemplateLabel: "flush"},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}},
+		}
+	}
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		finishedJob("flush-old", 3*time.Hour),
+		finishedJob("flush-older", 4*time.Hour),
+		finishedJob("flush-recent", time.Hour),
+	).WithStatusSubresource(&batchv1.Job{}).Build()
+	destination := v1alpha1.DestinationToWatch{Type: "JobTemplate", JobTemplate: &v1alpha1.JobTemplateDestination{
+		Name:         "flush",
+		Namespace:    "payments",
+		Template:     &v1alpha1.JobTemplate{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "flush"}}}}}},
+		HistoryLimit: ptr.To(int32(2)),
+		Timeout:      &metav1.Duration{Duration: time.Second},
+	}}
+	h := (&Provider{}).NewHandler(ctx, c, destination)
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", Namespace: "payments"}
+
+	objs, err := h.Filter(&destination, event)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	require.NoError(t, h.Apply(objs[0], event))
+	again, err := h.Filter(&destination, event)
+	require.NoError(t, err)
+	require.NoError(t, h.Apply(again[0], event), "applying the same event twice is a noop")
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(ctx, &jobs))
+	names := []string{}
+	for _, job := range jobs.Items {
+		names = append(names, job.Name)
+	}
+	assert.ElementsMatch(t, []string{objs[0].GetName(), "flush-recent", "flush-old"}, names)
+
+	created := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(objs[0]), created))
+	created.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+	require.NoError(t, c.Status().Update(ctx, created))
+	assert.ErrorIs(t, h.WaitFor(objs[0]), errJobFailed)
+
+	created.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	require.NoError(t, c.Status().Update(ctx, created))
+	assert.NoError(t, h.WaitFor(objs[0]))
+}
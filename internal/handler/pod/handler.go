@@ -0,0 +1,285 @@
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	defaultMaxUnavailable = 1
+	defaultTimeout        = 10 * time.Minute
+)
+
+// pollInterval is the time between two checks while waiting. It is a variable so tests can shorten it.
+var pollInterval = time.Second
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+
+	// readyBefore is the number of Ready Pods of the controller of every evicted Pod, before its eviction.
+	readyBefore map[types.UID]int
+}
+
+// Filter returns the watched Pods that are not already being deleted.
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.Pod == nil {
+		return nil, errors.New("destination isn't type Pod")
+	}
+	logger := log.FromContext(h.ctx)
+	var pods corev1.PodList
+	var opts []client.ListOption
+	if event.Namespace != "" {
+		opts = append(opts, client.InNamespace(event.Namespace))
+	}
+	if err := h.client.List(h.ctx, &pods, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list Pods:%w", err)
+	}
+	for key, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		isWatched, err := h.isResourceWatched(pod, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if Pod is watched", "name", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+		if isWatched {
+			objs = append(objs, &pods.Items[key])
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply evicts the Pod once fewer than MaxUnavailable Pods of its controller are unavailable.
+// Evictions refused by a PodDisruptionBudget are retried until the timeout.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return errors.New("obj isn't type Pod")
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner != nil {
+		maxUnavailable := defaultMaxUnavailable
+		if cfg := h.destinationCache.Pod; cfg != nil && cfg.MaxUnavailable > 0 {
+			maxUnavailable = int(cfg.MaxUnavailable)
+		}
+		err := h.poll(fmt.Sprintf("fewer than %d unavailable Pods of %s %s", maxUnavailable, owner.Kind, owner.Name), func() (bool, error) {
+			ready, unavailable, err := h.siblings(pod, owner.UID)
+			h.readyBefore[pod.UID] = ready
+			return unavailable < maxUnavailable, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+	err := h.poll("eviction allowed by PodDisruptionBudget", func() (bool, error) {
+		err := h.client.SubResource("eviction").Create(h.ctx, pod, eviction)
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+			return true, nil
+		case apierrors.IsTooManyRequests(err):
+			logger.V(1).Info("Eviction blocked by PodDisruptionBudget", "name", pod.GetName(), "namespace", pod.GetNamespace())
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to evict Pod:%w", err)
+	})
+	if err != nil {
+		return err
+	}
+	logger.V(1).Info("Evicted Pod", "name", pod.GetName(), "namespace", pod.GetNamespace())
+	return nil
+}
+
+// siblings returns the number of Ready and of unavailable Pods controlled by owner, in the namespace of target.
+// Pods being deleted count as unavailable, but target does not: evicting it does not make it any less available,
+// e.g. when it is crash-looping on stale credentials.
+func (h *Handler) siblings(target *corev1.Pod, owner types.UID) (ready, unavailable int, err error) {
+	var pods corev1.PodList
+	if err := h.client.List(h.ctx, &pods, client.InNamespace(target.Namespace)); err != nil {
+		return 0, 0, fmt.Errorf("failed to list Pods:%w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if ref := metav1.GetControllerOf(pod); ref == nil || ref.UID != owner {
+			continue
+		}
+		switch {
+		case pod.DeletionTimestamp == nil && isPodReady(pod):
+			ready++
+		case pod.UID != target.UID:
+			unavailable++
+		}
+	}
+	return ready, unavailable, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// poll calls fn until it returns true, an error, or the timeout expires.
+func (h *Handler) poll(waitingFor string, fn func() (bool, error)) error {
+	timeout := defaultTimeout
+	if cfg := h.destinationCache.Pod; cfg != nil && cfg.Timeout != nil {
+		timeout = cfg.Timeout.Duration
+	}
+	deadline := time.After(timeout)
+	for {
+		done, err := fn()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("timeout waiting for %s", waitingFor)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// isResourceWatched determines if a single Pod matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(pod corev1.Pod, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.Pod
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not Pod")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, &pod, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, &pod, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(&pod, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor waits, if WaitForReplacement is set, until the evicted Pod is gone and
+// its controller has as many Ready Pods as before the eviction.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	if h.destinationCache.Pod == nil || !h.destinationCache.Pod.WaitForReplacement {
+		return nil
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return errors.New("obj isn't type Pod")
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return nil
+	}
+	logger.V(1).Info("Waiting for Pod replacement", "name", pod.GetName(), "namespace", pod.GetNamespace())
+	return h.poll(fmt.Sprintf("replacement of Pod %s/%s", pod.Namespace, pod.Name), func() (bool, error) {
+		current := &corev1.Pod{}
+		err := h.client.Get(h.ctx, client.ObjectKeyFromObject(pod), current)
+		if err == nil && current.UID == pod.UID {
+			return false, nil
+		}
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to get Pod: %w", err)
+		}
+		ready, _, err := h.siblings(pod, owner.UID)
+		return ready >= h.readyBefore[pod.UID], err
+	})
+}
+
+func (h *Handler) References(obj client.Object, identifier string) (bool, error) {
+	return h.referenceFn(obj, identifier)
+}
+
+// _references checks if the Pod references the given secret identifier.
+// It is the default References implementation
+func (h *Handler) _references(obj client.Object, identifier string) (bool, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false, errors.New("obj isn't type Pod")
+	}
+	return util.PodSpecReferences(&pod.Spec, identifier), nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
@@ -0,0 +1,117 @@
+package pod
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const ownerUID = types.UID("rs-uid")
+
+func newPod(name string, owned, ready bool) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}},
+			Volumes:    []corev1.Volume{{Name: "creds", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "db"}}}},
+		},
+	}
+	if owned {
+		pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app", UID: ownerUID, Controller: ptr.To(true)}}
+	}
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}
+	return pod
+}
+
+func TestPodHandler(t *testing.T) {
+	pollInterval = time.Millisecond
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", Namespace: "default"}
+
+	testCases := []struct {
+		name               string
+		pods               []client.Object
+		maxUnavailable     int32
+		waitForReplacement bool
+		blockedEvictions   int
+		replace            bool
+		wantApplyErr       bool
+		wantWaitErr        bool
+	}{
+		{name: "bare pod", pods: []client.Object{newPod("target", false, true)}},
+		{name: "retries evictions blocked by a PodDisruptionBudget", pods: []client.Object{newPod("target", true, true)}, blockedEvictions: 2},
+		{name: "too many unavailable", pods: []client.Object{newPod("target", true, true), newPod("starting", true, false)}, wantApplyErr: true},
+		{name: "target not ready", pods: []client.Object{newPod("target", true, false), newPod("other", true, true)}},
+		{name: "within max unavailable", pods: []client.Object{newPod("target", true, true), newPod("starting", true, false)}, maxUnavailable: 2},
+		{name: "replacement ready", pods: []client.Object{newPod("target", true, true), newPod("other", true, true)}, waitForReplacement: true, replace: true},
+		{name: "replacement missing", pods: []client.Object{newPod("target", true, true), newPod("other", true, true)}, waitForReplacement: true, wantWaitErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			blocked := tc.blockedEvictions
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.pods...).WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceCreate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, subResourceObj client.Object, opts ...client.SubResourceCreateOption) error {
+					if blocked > 0 {
+						blocked--
+						return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+					}
+					if err := c.SubResource(subResource).Create(ctx, obj, subResourceObj, opts...); err != nil {
+						return err
+					}
+					if tc.replace {
+						return c.Create(ctx, newPod("replacement", true, true))
+					}
+					return nil
+				},
+			}).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "Pod", Pod: &v1alpha1.PodDestination{
+				Names:              []string{"target"},
+				MaxUnavailable:     tc.maxUnavailable,
+				WaitForReplacement: tc.waitForReplacement,
+				Timeout:            &metav1.Duration{Duration: 50 * time.Millisecond},
+			}}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 1)
+			referenced, err := h.References(objs[0], "db")
+			require.NoError(t, err)
+			assert.True(t, referenced)
+
+			err = h.Apply(objs[0], event)
+			if tc.wantApplyErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(objs[0]), &corev1.Pod{})))
+
+			err = h.WaitFor(objs[0])
+			if tc.wantWaitErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
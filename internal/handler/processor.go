@@ -9,6 +9,7 @@ import (
 
 	esov1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
 	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/remote"
 	"github.com/external-secrets-inc/reloader/internal/handler/schema"
 )
 
@@ -16,6 +17,7 @@ type EventHandler struct {
 	ctx    context.Context
 	client client.Client
 	cache  []esov1alpha1.DestinationToWatch
+	remote *remote.Clients
 }
 
 func NewEventHandler(client client.Client) *EventHandler {
@@ -23,11 +25,40 @@ func NewEventHandler(client client.Client) *EventHandler {
 	return &EventHandler{
 		ctx:    ctx,
 		client: client,
+		remote: remote.NewClients(client),
 	}
 }
 
 func (h *EventHandler) UpdateDestinationsToWatch(watch []esov1alpha1.DestinationToWatch) {
 	h.cache = watch
+	h.remote.Prune(watch)
+}
+
+// ClusterHealthResult is the health of a remote cluster.
+type ClusterHealthResult struct {
+	Name string
+	Err  error
+}
+
+// ClusterHealth checks the remote clusters used by the given destinations.
+// Destinations without a cluster are skipped. The returned map is keyed by remote.Key,
+// as clusters of different destinations may share a name.
+func (h *EventHandler) ClusterHealth(ctx context.Context, watch []esov1alpha1.DestinationToWatch) map[string]ClusterHealthResult {
+	health := map[string]ClusterHealthResult{}
+	for _, d := range watch {
+		if d.Cluster == nil {
+			continue
+		}
+		key, err := remote.Key(d.Cluster)
+		if err != nil {
+			key = d.Cluster.Name
+		}
+		if _, ok := health[key]; ok {
+			continue
+		}
+		health[key] = ClusterHealthResult{Name: d.Cluster.Name, Err: h.remote.Health(ctx, d.Cluster)}
+	}
+	return health
 }
 
 func (h *EventHandler) HandleEvent(ctx context.Context, event events.SecretRotationEvent) error {
@@ -39,12 +70,19 @@ func (h *EventHandler) HandleEvent(ctx context.Context, event events.SecretRotat
 			logger.Info("Provider not found", "destination type", watchCriteria.Type)
 			continue
 		}
-		h := prov.NewHandler(ctx, h.client, watchCriteria)
+		c, err := h.remote.For(ctx, watchCriteria.Cluster)
+		if err != nil {
+			logger.Error(err, "failed to get cluster client", "cluster", watchCriteria.Cluster.Name, "type", watchCriteria.Type)
+			continue
+		}
+		h := prov.NewHandler(ctx, c, watchCriteria)
 		// Mutate Handler for different Update and Match Strategies
-		if watchCriteria.UpdateStrategy != nil {
+		// Generic destinations implement all strategies.
+		if watchCriteria.UpdateStrategy != nil && watchCriteria.Type != schema.GENERIC {
 			logger.Info("Optional Update strategies are not implemented", "UpdateStrategy", watchCriteria.UpdateStrategy)
 		}
-		if watchCriteria.MatchStrategy != nil {
+		// HttpCallback and JobTemplate destinations evaluate MatchStrategy themselves, as there is no object to reference secrets.
+		if watchCriteria.MatchStrategy != nil && watchCriteria.Type != schema.HTTP_CALLBACK && watchCriteria.Type != schema.JOB_TEMPLATE && watchCriteria.Type != schema.GENERIC {
 			logger.Info("Optional Match strategies are not implemented", "MatchStrategy", watchCriteria.MatchStrategy)
 		}
 		objs, err := h.Filter(&watchCriteria, event)
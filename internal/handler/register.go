@@ -1,8 +1,18 @@
 package handler
 
 import (
+	_ "github.com/external-secrets-inc/reloader/internal/handler/argocd"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/clusterexternalsecret"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/cronjob"
 	_ "github.com/external-secrets-inc/reloader/internal/handler/deployment"
 	_ "github.com/external-secrets-inc/reloader/internal/handler/externalsecret"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/flux"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/generic"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/httpcallback"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/job"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/jobtemplate"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/pod"
 	_ "github.com/external-secrets-inc/reloader/internal/handler/pushsecret"
+	_ "github.com/external-secrets-inc/reloader/internal/handler/rollout"
 	_ "github.com/external-secrets-inc/reloader/internal/handler/workflow"
 )
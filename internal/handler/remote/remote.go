@@ -0,0 +1,177 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/kubeconfig"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tokenRefreshAfter is how long clients using Auth.ServiceAccountRef are kept before requesting a new token.
+// It must stay below kubeconfig.ServiceAccountTokenTTL.
+const tokenRefreshAfter = 45 * time.Minute
+
+// healthTimeout bounds a health check, so an unreachable cluster does not block reconciles.
+const healthTimeout = 10 * time.Second
+
+type entry struct {
+	config  *rest.Config
+	client  client.Client
+	created time.Time
+	// credentials is the resource version of the secret holding the credentials, if any.
+	credentials string
+}
+
+// Clients caches clients for the remote clusters of destinations.
+type Clients struct {
+	local client.Client
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	now     func() time.Time
+}
+
+// NewClients returns a cache of remote clients.
+// Referenced secrets are read with local, and its scheme is used for the remote clients.
+func NewClients(local client.Client) *Clients {
+	return &Clients{
+		local:   local,
+		entries: map[string]*entry{},
+		now:     time.Now,
+	}
+}
+
+// For returns the client for the cluster. If cluster is nil, the local client is returned.
+func (c *Clients) For(ctx context.Context, cluster *v1alpha1.DestinationCluster) (client.Client, error) {
+	if cluster == nil {
+		return c.local, nil
+	}
+	e, err := c.get(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return e.client, nil
+}
+
+// Health checks that the API server of the cluster is reachable with the configured auth.
+func (c *Clients) Health(ctx context.Context, cluster *v1alpha1.DestinationCluster) error {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+	e, err := c.get(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	cfg := rest.CopyConfig(e.config)
+	cfg.Timeout = healthTimeout
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("could not create discovery client: %w", err)
+	}
+	if _, err := dc.ServerVersion(); err != nil {
+		return fmt.Errorf("could not reach cluster: %w", err)
+	}
+	return nil
+}
+
+// Prune removes the clients of clusters that are no longer used by any destination.
+func (c *Clients) Prune(destinations []v1alpha1.DestinationToWatch) {
+	used := map[string]bool{}
+	for _, d := range destinations {
+		if d.Cluster == nil {
+			continue
+		}
+		if key, err := Key(d.Cluster); err == nil {
+			used[key] = true
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if !used[key] {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *Clients) get(ctx context.Context, cluster *v1alpha1.DestinationCluster) (*entry, error) {
+	// Without auth, the default config of the reloader would silently target the local cluster instead.
+	if cluster.Auth == nil {
+		return nil, fmt.Errorf("cluster %s: auth is required", cluster.Name)
+	}
+	key, err := Key(cluster)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := c.credentialsVersion(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && e.credentials == credentials && !c.expired(cluster, e) {
+		return e, nil
+	}
+	// The client is built without holding the lock, as it may read secrets and request tokens.
+	cfg, err := kubeconfig.RESTConfig(ctx, c.local, cluster.ServerURL, cluster.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("could not build config for cluster %s: %w", cluster.Name, err)
+	}
+	cl, err := client.New(cfg, client.Options{Scheme: c.local.Scheme()})
+	if err != nil {
+		return nil, fmt.Errorf("could not create client for cluster %s: %w", cluster.Name, err)
+	}
+	e = &entry{config: cfg, client: cl, created: c.now(), credentials: credentials}
+	c.mu.Lock()
+	c.entries[key] = e
+	c.mu.Unlock()
+	return e, nil
+}
+
+// credentialsVersion returns the resource version of the secret referenced by the cluster auth, so clients
+// are rebuilt when the credentials rotate. Clusters without a referenced secret have no version.
+func (c *Clients) credentialsVersion(ctx context.Context, cluster *v1alpha1.DestinationCluster) (string, error) {
+	var ref *v1alpha1.SecretKeySelector
+	switch {
+	case cluster.Auth == nil:
+		return "", nil
+	case cluster.Auth.KubeConfigRef != nil:
+		ref = &cluster.Auth.KubeConfigRef.SecretRef
+	case cluster.Auth.TokenRef != nil:
+		ref = &cluster.Auth.TokenRef.SecretRef
+	default:
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.local.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("could not get credentials of cluster %s: %w", cluster.Name, err)
+	}
+	return secret.ResourceVersion, nil
+}
+
+func (c *Clients) expired(cluster *v1alpha1.DestinationCluster, e *entry) bool {
+	if cluster.Auth == nil || cluster.Auth.ServiceAccountRef == nil {
+		return false
+	}
+	return c.now().Sub(e.created) > tokenRefreshAfter
+}
+
+// Key identifies the configuration of the cluster. Clusters of different destinations may share a name.
+func Key(cluster *v1alpha1.DestinationCluster) (string, error) {
+	b, err := json.Marshal(cluster)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal cluster: %w", err)
+	}
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
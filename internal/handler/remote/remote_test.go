@@ -0,0 +1,94 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" || r.Header.Get("Authorization") != "Bearer remote-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"major":"1","minor":"33","gitVersion":"v1.33.0"}`))
+	}))
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "reloader"},
+		Data:       map[string][]byte{"token": []byte("remote-token"), "wrong": []byte("wrong-token")},
+	}
+	local := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	cluster := func(name, serverURL, key string) *v1alpha1.DestinationCluster {
+		return &v1alpha1.DestinationCluster{
+			Name:      name,
+			ServerURL: serverURL,
+			Auth: &v1alpha1.KubernetesAuth{TokenRef: &v1alpha1.TokenRef{
+				SecretRef: v1alpha1.SecretKeySelector{Name: "remote", Namespace: "reloader", Key: key},
+			}},
+		}
+	}
+	ctx := context.Background()
+	c := NewClients(local)
+
+	got, err := c.For(ctx, nil)
+	require.NoError(t, err)
+	assert.Same(t, local, got)
+
+	remote := cluster("remote", server.URL, "token")
+	a, err := c.For(ctx, remote)
+	require.NoError(t, err)
+	assert.NotSame(t, local, a)
+	b, err := c.For(ctx, cluster("remote", server.URL, "token"))
+	require.NoError(t, err)
+	assert.Same(t, a, b, "clients are cached by cluster configuration")
+
+	assert.NoError(t, c.Health(ctx, remote))
+	assert.Error(t, c.Health(ctx, cluster("unauthorized", server.URL, "wrong")))
+	assert.Error(t, c.Health(ctx, cluster("missing", server.URL, "missing")))
+	_, err = c.For(ctx, &v1alpha1.DestinationCluster{Name: "no-auth", ServerURL: server.URL})
+	assert.ErrorContains(t, err, "auth is required")
+	_, err = c.For(ctx, &v1alpha1.DestinationCluster{Name: "name-only"})
+	assert.ErrorContains(t, err, "auth is required", "a cluster without auth would be the local one")
+
+	// Rotated credentials rebuild the client.
+	secret.Data["token"] = []byte("rotated-token")
+	require.NoError(t, local.Update(ctx, secret))
+	rotated, err := c.For(ctx, remote)
+	require.NoError(t, err)
+	assert.NotSame(t, a, rotated)
+	assert.Error(t, c.Health(ctx, remote), "the server does not accept the rotated token")
+
+	c.Prune([]v1alpha1.DestinationToWatch{{Cluster: remote}})
+	assert.Len(t, c.entries, 1)
+	c.Prune(nil)
+	assert.Empty(t, c.entries)
+}
+
+func TestClientsRefreshServiceAccountTokens(t *testing.T) {
+	local := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	now := time.Now()
+	c := NewClients(local)
+	c.now = func() time.Time { return now }
+	e := &entry{created: now}
+
+	token := &v1alpha1.DestinationCluster{Auth: &v1alpha1.KubernetesAuth{TokenRef: &v1alpha1.TokenRef{}}}
+	serviceAccount := &v1alpha1.DestinationCluster{Auth: &v1alpha1.KubernetesAuth{ServiceAccountRef: &v1alpha1.ServiceAccountSelector{}}}
+	assert.False(t, c.expired(serviceAccount, e))
+
+	now = now.Add(tokenRefreshAfter + time.Second)
+	assert.True(t, c.expired(serviceAccount, e))
+	assert.False(t, c.expired(token, e))
+}
@@ -0,0 +1,267 @@
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/handler/schema"
+	"github.com/external-secrets-inc/reloader/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kruntime "k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	phaseHealthy     = "Healthy"
+	phasePaused      = "Paused"
+	phaseDegraded    = "Degraded"
+	rolloutTimeout   = 10 * time.Minute
+	rolloutPollEvery = 100 * time.Millisecond
+)
+
+var rolloutGVK = kruntime.GroupVersionKind{
+	Group:   "argoproj.io",
+	Version: "v1alpha1",
+	Kind:    "Rollout",
+}
+
+// errRolloutAborted is returned by WaitFor when the Rollout was aborted or degraded.
+var errRolloutAborted = errors.New("rollout aborted")
+
+type Handler struct {
+	ctx              context.Context
+	client           client.Client
+	destinationCache v1alpha1.DestinationToWatch
+	applyFn          schema.ApplyFn
+	referenceFn      schema.ReferenceFn
+	waitForFn        schema.WaitForFn
+}
+
+func (h *Handler) Filter(destination *v1alpha1.DestinationToWatch, event events.SecretRotationEvent) ([]client.Object, error) {
+	objs := []client.Object{}
+	if destination.Rollout == nil {
+		return nil, errors.New("destination isn't type Rollout")
+	}
+	logger := log.FromContext(h.ctx)
+	rollouts := &unstructured.UnstructuredList{}
+	rollouts.SetGroupVersionKind(rolloutGVK.GroupVersion().WithKind(rolloutGVK.Kind + "List"))
+	var opts []client.ListOption
+	if event.Namespace != "" {
+		opts = append(opts, client.InNamespace(event.Namespace))
+	}
+	if err := h.client.List(h.ctx, rollouts, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list Rollouts: %w", err)
+	}
+	for i := range rollouts.Items {
+		ro := &rollouts.Items[i]
+		isWatched, err := h.isResourceWatched(ro, h.destinationCache)
+		if err != nil {
+			logger.Error(err, "failed to check if Rollout is watched", "name", ro.GetName(), "namespace", ro.GetNamespace())
+			continue
+		}
+		if isWatched {
+			objs = append(objs, ro)
+		}
+	}
+	return objs, nil
+}
+
+func (h *Handler) Apply(obj client.Object, event events.SecretRotationEvent) error {
+	return h.applyFn(obj, event)
+}
+
+// _apply restarts the Rollout with the configured UpdateMethod.
+func (h *Handler) _apply(obj client.Object, event events.SecretRotationEvent) error {
+	logger := log.FromContext(h.ctx)
+	ro, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return errors.New("obj isn't type Rollout")
+	}
+	method := v1alpha1.RolloutUpdateMethodPodTemplateAnnotations
+	if h.destinationCache.Rollout != nil && h.destinationCache.Rollout.UpdateMethod != "" {
+		method = h.destinationCache.Rollout.UpdateMethod
+	}
+	switch method {
+	case v1alpha1.RolloutUpdateMethodRestartAt:
+		if err := unstructured.SetNestedField(ro.Object, time.Now().UTC().Format(time.RFC3339), "spec", "restartAt"); err != nil {
+			return fmt.Errorf("failed to set restartAt: %w", err)
+		}
+	default:
+		annotations, _, err := unstructured.NestedStringMap(ro.Object, "spec", "template", "metadata", "annotations")
+		if err != nil {
+			return fmt.Errorf("failed to read pod template annotations: %w", err)
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations["reloader.external-secrets.io/last-reloaded"] = event.RotationTimestamp
+		annotations["reloader.external-secrets.io/trigger-source"] = event.TriggerSource
+		if err := unstructured.SetNestedStringMap(ro.Object, annotations, "spec", "template", "metadata", "annotations"); err != nil {
+			return fmt.Errorf("failed to set pod template annotations: %w", err)
+		}
+	}
+	if err := h.client.Update(h.ctx, ro); err != nil {
+		return fmt.Errorf("failed to update Rollout:%w", err)
+	}
+	logger.V(1).Info("Restarted Rollout", "name", ro.GetName(), "namespace", ro.GetNamespace(), "method", method)
+	return nil
+}
+
+// isResourceWatched determines if a single Rollout matches any of the SecretsToWatch criteria.
+func (h *Handler) isResourceWatched(obj client.Object, w v1alpha1.DestinationToWatch) (bool, error) {
+	watchCriteria := w.Rollout
+	if watchCriteria == nil {
+		return false, errors.New("watch type is not Rollout")
+	}
+	// Preprocess NamespaceSelectors
+	namespaceSelectors := make([]labels.Selector, 0, len(watchCriteria.NamespaceSelectors))
+	for _, nsSelector := range watchCriteria.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&nsSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %v", err)
+		}
+		namespaceSelectors = append(namespaceSelectors, selector)
+	}
+
+	// Preprocess LabelSelectors
+	var labelSelector labels.Selector
+	var err error
+	if watchCriteria.LabelSelectors != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(watchCriteria.LabelSelectors)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	// Preprocess Names into a map
+	nameSet := make(map[string]struct{})
+	for _, name := range watchCriteria.Names {
+		nameSet[name] = struct{}{}
+	}
+
+	// Perform matching
+	namespaceMatch, err := util.MatchesAnyNamespaceSelector(h.ctx, obj, namespaceSelectors, h.client)
+	if err != nil {
+		return false, err
+	}
+	labelMatch, err := util.MatchesLabelSelectors(h.ctx, obj, labelSelector, h.client)
+	if err != nil {
+		return false, err
+	}
+	nameMatch := util.IsNameInList(obj, nameSet)
+	if namespaceMatch && labelMatch && nameMatch {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (h *Handler) WaitFor(obj client.Object) error {
+	return h.waitForFn(obj)
+}
+
+// _waitFor follows the Rollout phase until it is Healthy, or paused on a canary step unless WaitForPromotion is set.
+// An aborted or degraded Rollout fails immediately.
+func (h *Handler) _waitFor(obj client.Object) error {
+	logger := log.FromContext(h.ctx)
+	waitForPromotion := h.destinationCache.Rollout != nil && h.destinationCache.Rollout.WaitForPromotion
+
+	logger.V(1).Info("Waiting for Rollout to complete", "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	ticker := time.NewTicker(rolloutPollEvery)
+	defer ticker.Stop()
+
+	timeout := time.After(rolloutTimeout)
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for rollout %s/%s to complete", obj.GetNamespace(), obj.GetName())
+		case <-ticker.C:
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(rolloutGVK)
+			if err := h.client.Get(h.ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				return fmt.Errorf("failed to get rollout: %w", err)
+			}
+			done, err := isRolloutComplete(current, waitForPromotion)
+			if err != nil {
+				return fmt.Errorf("rollout %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			if done {
+				logger.V(1).Info("Rollout completed successfully", "name", obj.GetName(), "namespace", obj.GetNamespace())
+				return nil
+			}
+		}
+	}
+}
+
+// isRolloutComplete checks the Rollout status the same way `kubectl argo rollouts status` does.
+func isRolloutComplete(ro *unstructured.Unstructured, waitForPromotion bool) (bool, error) {
+	// Argo Rollouts reports observedGeneration as a string.
+	observed, _, _ := unstructured.NestedFieldNoCopy(ro.Object, "status", "observedGeneration")
+	if fmt.Sprint(observed) != fmt.Sprint(ro.GetGeneration()) {
+		return false, nil
+	}
+	message, _, _ := unstructured.NestedString(ro.Object, "status", "message")
+	if aborted, _, _ := unstructured.NestedBool(ro.Object, "status", "abort"); aborted {
+		return false, fmt.Errorf("%w: %s", errRolloutAborted, message)
+	}
+	phase, _, _ := unstructured.NestedString(ro.Object, "status", "phase")
+	switch phase {
+	case phaseHealthy:
+		return true, nil
+	case phaseDegraded:
+		return false, fmt.Errorf("%w: degraded: %s", errRolloutAborted, message)
+	case phasePaused:
+		return !waitForPromotion, nil
+	}
+	return false, nil
+}
+
+func (h *Handler) References(obj client.Object, identifier string) (bool, error) {
+	return h.referenceFn(obj, identifier)
+}
+
+// _references checks if the pod template of the Rollout references the given secret identifier.
+// It is the default References implementation
+func (h *Handler) _references(obj client.Object, identifier string) (bool, error) {
+	ro, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false, errors.New("obj isn't type Rollout")
+	}
+	podSpec, found, err := unstructured.NestedMap(ro.Object, "spec", "template", "spec")
+	if err != nil || !found {
+		return false, err
+	}
+	var spec corev1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpec, &spec); err != nil {
+		return false, fmt.Errorf("failed to convert pod template: %w", err)
+	}
+	return util.PodSpecReferences(&spec, identifier), nil
+}
+
+func (h *Handler) WithApply(apply schema.ApplyFn) schema.Handler {
+	h.applyFn = apply
+	return h
+}
+
+func (h *Handler) WithReference(ref schema.ReferenceFn) schema.Handler {
+	h.referenceFn = ref
+	return h
+}
+
+func (h *Handler) WithWaitFor(waitFor schema.WaitForFn) schema.Handler {
+	h.waitForFn = waitFor
+	return h
+}
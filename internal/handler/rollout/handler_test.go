@@ -0,0 +1,110 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newRollout(name, secretName string, status map[string]any) *unstructured.Unstructured {
+	ro := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": "default", "generation": int64(2)},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":    "app",
+						"envFrom": []any{map[string]any{"secretRef": map[string]any{"name": secretName}}},
+					}},
+				},
+			},
+		},
+		"status": status,
+	}}
+	ro.SetGroupVersionKind(rolloutGVK)
+	return ro
+}
+
+func TestIsRolloutComplete(t *testing.T) {
+	testCases := []struct {
+		name             string
+		status           map[string]any
+		waitForPromotion bool
+		wantDone         bool
+		wantErr          bool
+	}{
+		{name: "healthy", status: map[string]any{"observedGeneration": "2", "phase": "Healthy"}, wantDone: true},
+		{name: "not observed yet", status: map[string]any{"observedGeneration": "1", "phase": "Healthy"}},
+		{name: "progressing", status: map[string]any{"observedGeneration": "2", "phase": "Progressing"}},
+		{name: "paused canary", status: map[string]any{"observedGeneration": "2", "phase": "Paused"}, wantDone: true},
+		{name: "paused canary waiting for promotion", status: map[string]any{"observedGeneration": "2", "phase": "Paused"}, waitForPromotion: true},
+		{name: "aborted", status: map[string]any{"observedGeneration": "2", "phase": "Degraded", "abort": true, "message": "RolloutAborted"}, wantErr: true},
+		{name: "degraded", status: map[string]any{"observedGeneration": "2", "phase": "Degraded"}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			done, err := isRolloutComplete(newRollout("app", "db", tc.status), tc.waitForPromotion)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errRolloutAborted)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDone, done)
+		})
+	}
+}
+
+func TestRolloutHandler(t *testing.T) {
+	testCases := []struct {
+		name   string
+		method v1alpha1.RolloutUpdateMethod
+		path   []string
+	}{
+		{name: "pod template annotations", path: []string{"spec", "template", "metadata", "annotations", "reloader.external-secrets.io/last-reloaded"}},
+		{name: "restart at", method: v1alpha1.RolloutUpdateMethodRestartAt, path: []string{"spec", "restartAt"}},
+	}
+	event := events.SecretRotationEvent{SecretIdentifier: "db", RotationTimestamp: "2024-01-01T00:00:00Z", TriggerSource: "test"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				newRollout("app", "db", map[string]any{"observedGeneration": "2", "phase": "Healthy"}),
+				newRollout("other", "cache", nil),
+			).Build()
+			destination := v1alpha1.DestinationToWatch{Type: "Rollout", Rollout: &v1alpha1.RolloutDestination{UpdateMethod: tc.method}}
+			h := (&Provider{}).NewHandler(ctx, c, destination)
+
+			objs, err := h.Filter(&destination, event)
+			require.NoError(t, err)
+			require.Len(t, objs, 2)
+			var referenced []client.Object
+			for _, obj := range objs {
+				ok, err := h.References(obj, event.SecretIdentifier)
+				require.NoError(t, err)
+				if ok {
+					referenced = append(referenced, obj)
+				}
+			}
+			require.Len(t, referenced, 1)
+			require.NoError(t, h.Apply(referenced[0], event))
+
+			updated := &unstructured.Unstructured{}
+			updated.SetGroupVersionKind(rolloutGVK)
+			require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "app"}, updated))
+			value, found, err := unstructured.NestedString(updated.Object, tc.path...)
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.NotEmpty(t, value)
+		})
+	}
+}
@@ -10,10 +10,20 @@ import (
 )
 
 const (
-	EXTERNAL_SECRET = "ExternalSecret"
-	PUSH_SECRET     = "PushSecret"
-	DEPLOYMENT      = "Deployment"
-	WORKFLOW        = "WorkflowRunTemplate"
+	EXTERNAL_SECRET         = "ExternalSecret"
+	PUSH_SECRET             = "PushSecret"
+	DEPLOYMENT              = "Deployment"
+	WORKFLOW                = "WorkflowRunTemplate"
+	CRON_JOB                = "CronJob"
+	JOB                     = "Job"
+	ROLLOUT                 = "Rollout"
+	FLUX                    = "Flux"
+	ARGOCD_APP              = "ArgoCDApplication"
+	HTTP_CALLBACK           = "HttpCallback"
+	JOB_TEMPLATE            = "JobTemplate"
+	POD                     = "Pod"
+	GENERIC                 = "Generic"
+	CLUSTER_EXTERNAL_SECRET = "ClusterExternalSecret"
 )
 
 type ApplyFn func(obj client.Object, event events.SecretRotationEvent) error
@@ -0,0 +1,190 @@
+package amqp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultHeartbeat      = 10 * time.Second
+	defaultPrefetch       = 10
+	defaultReconnectDelay = 5 * time.Second
+	defaultExchangeType   = "topic"
+)
+
+// Amqp consumes secret change events from an AMQP 0-9-1 queue.
+type Amqp struct {
+	config     *v1alpha1.AmqpConfig
+	context    context.Context
+	cancel     context.CancelFunc
+	client     client.Client
+	eventChan  chan events.SecretRotationEvent
+	logger     logr.Logger
+	dialConfig amqp091.Config
+	done       chan struct{}
+}
+
+// Start connects to the broker and starts consuming the queue. Lost connections are re-established until Stop is called.
+func (h *Amqp) Start() error {
+	conn, err := amqp091.DialConfig(h.config.URL, h.dialConfig)
+	if err != nil {
+		return fmt.Errorf("could not connect to amqp broker: %w", err)
+	}
+	h.logger.Info("Started consuming amqp queue", "queue", h.config.Queue)
+	h.done = make(chan struct{})
+	go h.run(conn)
+	return nil
+}
+
+// Stop stops consuming and closes the broker connection.
+func (h *Amqp) Stop() error {
+	h.cancel()
+	if h.done != nil {
+		<-h.done
+	}
+	return nil
+}
+
+func (h *Amqp) run(conn *amqp091.Connection) {
+	defer close(h.done)
+	for {
+		err := h.consume(conn)
+		_ = conn.Close()
+		if h.context.Err() != nil {
+			return
+		}
+		h.logger.Error(err, "Lost amqp connection, reconnecting", "queue", h.config.Queue)
+		for {
+			if !h.wait() {
+				return
+			}
+			conn, err = amqp091.DialConfig(h.config.URL, h.dialConfig)
+			if err == nil {
+				h.logger.Info("Reconnected to amqp broker", "queue", h.config.Queue)
+				break
+			}
+			h.logger.Error(err, "could not reconnect to amqp broker")
+		}
+	}
+}
+
+// consume sets up the channel and handles deliveries until the connection is lost or the listener is stopped.
+func (h *Amqp) consume(conn *amqp091.Connection) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("could not open amqp channel: %w", err)
+	}
+	if err := h.setup(ch); err != nil {
+		return err
+	}
+	deliveries, err := ch.ConsumeWithContext(h.context, h.config.Queue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("could not consume amqp queue %s: %w", h.config.Queue, err)
+	}
+	for {
+		select {
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return errors.New("amqp delivery channel closed")
+			}
+			h.handleDelivery(delivery)
+		case <-h.context.Done():
+			return nil
+		}
+	}
+}
+
+func (h *Amqp) setup(ch *amqp091.Channel) error {
+	prefetch := int(h.config.Prefetch)
+	if prefetch == 0 {
+		prefetch = defaultPrefetch
+	}
+	if err := ch.Qos(prefetch, 0, false); err != nil {
+		return fmt.Errorf("could not set amqp prefetch: %w", err)
+	}
+	if h.config.DeclareQueue {
+		if _, err := ch.QueueDeclare(h.config.Queue, true, false, false, false, nil); err != nil {
+			return fmt.Errorf("could not declare amqp queue %s: %w", h.config.Queue, err)
+		}
+	}
+	exchange := h.config.Exchange
+	if exchange == nil {
+		return nil
+	}
+	if exchange.Declare {
+		kind := exchange.Type
+		if kind == "" {
+			kind = defaultExchangeType
+		}
+		if err := ch.ExchangeDeclare(exchange.Name, kind, true, false, false, false, nil); err != nil {
+			return fmt.Errorf("could not declare amqp exchange %s: %w", exchange.Name, err)
+		}
+	}
+	routingKeys := exchange.RoutingKeys
+	if len(routingKeys) == 0 {
+		routingKeys = []string{""}
+	}
+	for _, key := range routingKeys {
+		if err := ch.QueueBind(h.config.Queue, key, exchange.Name, false, nil); err != nil {
+			return fmt.Errorf("could not bind amqp queue %s to exchange %s: %w", h.config.Queue, exchange.Name, err)
+		}
+	}
+	return nil
+}
+
+// handleDelivery acks a delivery once its events were handed off. Messages that cannot be parsed are
+// rejected without requeue, so they go to the dead letter exchange if one is configured.
+// Messages interrupted by Stop are requeued.
+func (h *Amqp) handleDelivery(delivery amqp091.Delivery) {
+	h.logger.V(1).Info("Processing Message", "Message", string(delivery.Body))
+	rotationEvents, err := payload.ExtractEvents(string(delivery.Body), payload.Paths{
+		Identifier: h.config.SecretIdentifierOnPayload,
+		Namespace:  h.config.NamespacePathOnPayload,
+		Timestamp:  h.config.TimestampPathOnPayload,
+		EventType:  h.config.EventTypePathOnPayload,
+	}, schema.AMQP)
+	if err != nil {
+		h.logger.Error(err, "could not extract events from message", "queue", h.config.Queue, "deliveryTag", delivery.DeliveryTag)
+		if err := delivery.Nack(false, false); err != nil {
+			h.logger.Error(err, "could not nack message", "deliveryTag", delivery.DeliveryTag)
+		}
+		return
+	}
+	for _, event := range rotationEvents {
+		select {
+		case h.eventChan <- event:
+			h.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-h.context.Done():
+			if err := delivery.Nack(false, true); err != nil {
+				h.logger.Error(err, "could not requeue message", "deliveryTag", delivery.DeliveryTag)
+			}
+			return
+		}
+	}
+	if err := delivery.Ack(false); err != nil {
+		h.logger.Error(err, "could not ack message", "deliveryTag", delivery.DeliveryTag)
+	}
+}
+
+func (h *Amqp) wait() bool {
+	delay := defaultReconnectDelay
+	if h.config.ReconnectDelay != nil {
+		delay = h.config.ReconnectDelay.Duration
+	}
+	select {
+	case <-time.After(delay):
+		return true
+	case <-h.context.Done():
+		return false
+	}
+}
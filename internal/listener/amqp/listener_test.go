@@ -0,0 +1,139 @@
+package amqp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeAcknowledger struct {
+	acked   bool
+	nacked  bool
+	requeue bool
+}
+
+func (f *fakeAcknowledger) Ack(uint64, bool) error {
+	f.acked = true
+	return nil
+}
+
+func (f *fakeAcknowledger) Nack(_ uint64, _ bool, requeue bool) error {
+	f.nacked = true
+	f.requeue = requeue
+	return nil
+}
+
+func (f *fakeAcknowledger) Reject(_ uint64, requeue bool) error {
+	return f.Nack(0, false, requeue)
+}
+
+func TestHandleDelivery(t *testing.T) {
+	testCases := []struct {
+		name        string
+		body        string
+		stopped     bool
+		wantEvents  []string
+		wantAck     bool
+		wantRequeue bool
+	}{
+		{
+			name:       "acks after delivery",
+			body:       `{"secrets":[{"name":"db"},{"name":"api"}]}`,
+			wantEvents: []string{"db", "api"},
+			wantAck:    true,
+		},
+		{
+			name: "rejects unparsable message without requeue",
+			body: `not json`,
+		},
+		{
+			name:        "requeues when stopped before delivery",
+			body:        `{"secrets":[{"name":"db"}]}`,
+			stopped:     true,
+			wantRequeue: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.stopped {
+				cancel()
+			}
+			eventChan := make(chan events.SecretRotationEvent, len(tc.wantEvents))
+			h := &Amqp{
+				config:    &v1alpha1.AmqpConfig{Queue: "rotations", SecretIdentifierOnPayload: "secrets.#.name"},
+				context:   ctx,
+				eventChan: eventChan,
+				logger:    logr.Discard(),
+			}
+			ack := &fakeAcknowledger{}
+			h.handleDelivery(amqp091.Delivery{Acknowledger: ack, Body: []byte(tc.body)})
+
+			assert.Equal(t, tc.wantAck, ack.acked)
+			assert.Equal(t, !tc.wantAck, ack.nacked)
+			assert.Equal(t, tc.wantRequeue, ack.requeue)
+			require.Len(t, eventChan, len(tc.wantEvents))
+			for _, want := range tc.wantEvents {
+				event := <-eventChan
+				assert.Equal(t, want, event.SecretIdentifier)
+				assert.Equal(t, "Amqp", event.TriggerSource)
+			}
+		})
+	}
+}
+
+func TestNewDialConfig(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "rabbitmq", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("reloader"), "password": []byte("s3cr3t")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	ref := func(key string) *v1alpha1.SecretKeySelector {
+		return &v1alpha1.SecretKeySelector{Name: "rabbitmq", Namespace: "default", Key: key}
+	}
+
+	dialConfig, err := newDialConfig(context.Background(), c, &v1alpha1.AmqpConfig{UsernameRef: ref("username"), PasswordRef: ref("password")})
+	require.NoError(t, err)
+	require.Len(t, dialConfig.SASL, 1)
+	assert.Equal(t, &amqp091.PlainAuth{Username: "reloader", Password: "s3cr3t"}, dialConfig.SASL[0])
+	assert.Nil(t, dialConfig.TLSClientConfig)
+
+	_, err = newDialConfig(context.Background(), c, &v1alpha1.AmqpConfig{UsernameRef: ref("username")})
+	assert.Error(t, err)
+	_, err = newDialConfig(context.Background(), c, &v1alpha1.AmqpConfig{UsernameRef: ref("username"), PasswordRef: ref("missing")})
+	assert.Error(t, err)
+}
+
+func TestStartFailsWithoutBroker(t *testing.T) {
+	listener, err := (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{
+		Type: "Amqp",
+		Amqp: &v1alpha1.AmqpConfig{
+			URL:                       "amqp://127.0.0.1:1/",
+			Queue:                     "rotations",
+			SecretIdentifierOnPayload: "name",
+			ReconnectDelay:            &metav1.Duration{Duration: time.Millisecond},
+		},
+	}, nil, nil, logr.Discard())
+	require.NoError(t, err)
+	assert.Error(t, listener.Start())
+	assert.NoError(t, listener.Stop())
+
+	_, err = (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{
+		Type: "Amqp",
+		Amqp: &v1alpha1.AmqpConfig{URL: "amqp://127.0.0.1:1/", SecretIdentifierOnPayload: "name"},
+	}, nil, nil, logr.Discard())
+	assert.Error(t, err)
+}
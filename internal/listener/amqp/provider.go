@@ -0,0 +1,85 @@
+package amqp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates an AMQP 0-9-1 queue consumer.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.Amqp == nil {
+		return nil, errors.New("amqp config is nil")
+	}
+	cfg := config.Amqp
+	if cfg.URL == "" || cfg.Queue == "" {
+		return nil, errors.New("amqp url and queue are required")
+	}
+	if cfg.SecretIdentifierOnPayload == "" {
+		return nil, errors.New("amqp identifierPathOnPayload is required")
+	}
+	if cfg.Exchange != nil && cfg.Exchange.Name == "" {
+		return nil, errors.New("amqp exchange name is required")
+	}
+
+	dialConfig, err := newDialConfig(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Amqp{
+		config:     cfg,
+		context:    ctx,
+		cancel:     cancel,
+		client:     client,
+		eventChan:  eventChan,
+		logger:     logger,
+		dialConfig: dialConfig,
+	}, nil
+}
+
+func newDialConfig(ctx context.Context, c client.Client, cfg *v1alpha1.AmqpConfig) (amqp091.Config, error) {
+	dialConfig := amqp091.Config{
+		Heartbeat: defaultHeartbeat,
+		Properties: amqp091.Table{
+			"connection_name": "reloader",
+		},
+	}
+	tlsConfig, err := tlsconfig.Build(ctx, c, cfg.TLS)
+	if err != nil {
+		return dialConfig, fmt.Errorf("could not build amqp tls config: %w", err)
+	}
+	dialConfig.TLSClientConfig = tlsConfig
+
+	if cfg.UsernameRef != nil || cfg.PasswordRef != nil {
+		if cfg.UsernameRef == nil || cfg.PasswordRef == nil {
+			return dialConfig, errors.New("amqp usernameSecretRef and passwordSecretRef must be set together")
+		}
+		username, err := resolvers.SecretKeyRef(ctx, c, cfg.UsernameRef)
+		if err != nil {
+			return dialConfig, fmt.Errorf("could not get amqp username: %w", err)
+		}
+		password, err := resolvers.SecretKeyRef(ctx, c, cfg.PasswordRef)
+		if err != nil {
+			return dialConfig, fmt.Errorf("could not get amqp password: %w", err)
+		}
+		dialConfig.SASL = []amqp091.Authentication{&amqp091.PlainAuth{Username: username, Password: password}}
+	}
+	return dialConfig, nil
+}
+
+func init() {
+	schema.RegisterProvider(schema.AMQP, &Provider{})
+}
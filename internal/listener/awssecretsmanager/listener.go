@@ -0,0 +1,110 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+)
+
+const currentStage = "AWSCURRENT"
+
+// SecretsManagerClientInterface is the subset of the Secrets Manager API used by the listener.
+type SecretsManagerClientInterface interface {
+	ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+}
+
+// AWSSecretsManagerPoll polls AWS Secrets Manager and emits events for secrets whose current version changed.
+type AWSSecretsManagerPoll struct {
+	config   *v1alpha1.AWSSecretsManagerPollConfig
+	context  context.Context
+	cancel   context.CancelFunc
+	logger   logr.Logger
+	smClient SecretsManagerClientInterface
+	poller   *poller.Poller
+}
+
+// Start begins polling AWS Secrets Manager.
+func (h *AWSSecretsManagerPoll) Start() error {
+	h.logger.Info("Started polling AWS Secrets Manager", "namePrefix", h.config.NamePrefix, "tags", h.config.Tags)
+	go h.poller.Run(h.context)
+	return nil
+}
+
+// Stop stops polling AWS Secrets Manager.
+func (h *AWSSecretsManagerPoll) Stop() error {
+	h.cancel()
+	return nil
+}
+
+// fetch lists the watched secrets and returns their AWSCURRENT version ID and last changed date.
+func (h *AWSSecretsManagerPoll) fetch(ctx context.Context) (map[string]string, error) {
+	input := &secretsmanager.ListSecretsInput{Filters: h.filters()}
+	versions := map[string]string{}
+	paginator := secretsmanager.NewListSecretsPaginator(h.smClient, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not list secrets: %w", err)
+		}
+		for _, secret := range page.SecretList {
+			if secret.Name == nil || !h.hasTags(secret.Tags) {
+				continue
+			}
+			versions[*secret.Name] = version(secret)
+		}
+	}
+	return versions, nil
+}
+
+func (h *AWSSecretsManagerPoll) filters() []types.Filter {
+	filters := []types.Filter{}
+	if h.config.NamePrefix != "" {
+		filters = append(filters, types.Filter{Key: types.FilterNameStringTypeName, Values: []string{h.config.NamePrefix}})
+	}
+	if len(h.config.Tags) > 0 {
+		// Tag keys and values are filtered independently by the API, so pairs are checked in hasTags.
+		keys := slices.Sorted(maps.Keys(h.config.Tags))
+		filters = append(filters, types.Filter{Key: types.FilterNameStringTypeTagKey, Values: keys})
+	}
+	return filters
+}
+
+func (h *AWSSecretsManagerPoll) hasTags(tags []types.Tag) bool {
+	for key, value := range h.config.Tags {
+		found := false
+		for _, tag := range tags {
+			if aws.ToString(tag.Key) == key && aws.ToString(tag.Value) == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func version(secret types.SecretListEntry) string {
+	current := ""
+	for id, stages := range secret.SecretVersionsToStages {
+		if slices.Contains(stages, currentStage) {
+			current = id
+			break
+		}
+	}
+	lastChanged := ""
+	if secret.LastChangedDate != nil {
+		lastChanged = secret.LastChangedDate.UTC().Format(time.RFC3339Nano)
+	}
+	return current + "@" + lastChanged
+}
@@ -0,0 +1,112 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// standIn serves ListSecrets like the Secrets Manager JSON API.
+type standIn struct {
+	mu       sync.Mutex
+	secrets  []map[string]any
+	requests []map[string]any
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Header.Get("X-Amz-Target") != "secretsmanager.ListSecrets" {
+		http.Error(w, "unsupported", http.StatusBadRequest)
+		return
+	}
+	request := map[string]any{}
+	_ = json.NewDecoder(r.Body).Decode(&request)
+	s.requests = append(s.requests, request)
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(map[string]any{"SecretList": s.secrets})
+}
+
+func (s *standIn) set(name, version string, changed int, tags map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secretTags := []map[string]string{}
+	for k, v := range tags {
+		secretTags = append(secretTags, map[string]string{"Key": k, "Value": v})
+	}
+	for _, secret := range s.secrets {
+		if secret["Name"] == name {
+			secret["SecretVersionsToStages"] = map[string][]string{version: {"AWSCURRENT"}, "old": {"AWSPREVIOUS"}}
+			secret["LastChangedDate"] = changed
+			return
+		}
+	}
+	s.secrets = append(s.secrets, map[string]any{
+		"Name":                   name,
+		"ARN":                    fmt.Sprintf("arn:aws:secretsmanager:eu-west-1:000000000000:secret:%s", name),
+		"SecretVersionsToStages": map[string][]string{version: {"AWSCURRENT"}},
+		"LastChangedDate":        changed,
+		"Tags":                   secretTags,
+	})
+}
+
+func TestPollDetectsCurrentVersionChanges(t *testing.T) {
+	stand := &standIn{}
+	stand.set("app/db", "v1", 1700000000, map[string]string{"team": "payments"})
+	stand.set("app/api", "v1", 1700000000, map[string]string{"team": "payments"})
+	stand.set("app/other", "v1", 1700000000, map[string]string{"team": "search"})
+	server := httptest.NewServer(stand)
+	defer server.Close()
+
+	smClient := secretsmanager.NewFromConfig(aws.Config{
+		Region:      "eu-west-1",
+		Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+	}, func(o *secretsmanager.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	h := &AWSSecretsManagerPoll{
+		config: &v1alpha1.AWSSecretsManagerPollConfig{
+			NamePrefix: "app/",
+			Tags:       map[string]string{"team": "payments"},
+		},
+		logger:   logr.Discard(),
+		smClient: smClient,
+	}
+	p := poller.New(nil, nil, h.fetch, schema.AWS_SECRETS_MANAGER_POLL, eventChan, logr.Discard())
+
+	ctx := context.Background()
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+	require.Len(t, stand.requests, 1)
+	assert.Equal(t, []any{
+		map[string]any{"Key": "name", "Values": []any{"app/"}},
+		map[string]any{"Key": "tag-key", "Values": []any{"team"}},
+	}, stand.requests[0]["Filters"])
+
+	stand.set("app/db", "v2", 1700000100, nil)
+	stand.set("app/other", "v2", 1700000100, nil)
+	require.NoError(t, p.Poll(ctx))
+	require.Len(t, eventChan, 1)
+	event := <-eventChan
+	assert.Equal(t, "app/db", event.SecretIdentifier)
+	assert.Equal(t, "AwsSecretsManagerPoll", event.TriggerSource)
+
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+}
@@ -0,0 +1,57 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/mapper"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	authAWS "github.com/external-secrets-inc/reloader/pkg/auth/aws"
+	modelAWS "github.com/external-secrets-inc/reloader/pkg/models/aws"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a listener polling AWS Secrets Manager for secret changes.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.AwsSecretsManagerPoll == nil {
+		return nil, errors.New("aws secrets manager poll config is nil")
+	}
+	cfg := config.AwsSecretsManagerPoll
+	authConfig, err := mapper.TransformConfig[modelAWS.AWSSDKAuth](cfg.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	awsConfig, err := authAWS.CreateAWSSDKConfig(ctx, client, authConfig, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config: %w", err)
+	}
+	smClient := secretsmanager.NewFromConfig(awsConfig, func(o *secretsmanager.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	h := &AWSSecretsManagerPoll{
+		config:   cfg,
+		context:  ctx,
+		cancel:   cancel,
+		logger:   logger,
+		smClient: smClient,
+	}
+	h.poller = poller.New(cfg.Poll, client, h.fetch, schema.AWS_SECRETS_MANAGER_POLL, eventChan, logger)
+	return h, nil
+}
+
+func init() {
+	schema.RegisterProvider(schema.AWS_SECRETS_MANAGER_POLL, &Provider{})
+}
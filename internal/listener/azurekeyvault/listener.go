@@ -0,0 +1,135 @@
+package azurekeyvault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+)
+
+// AzureKeyVaultPoll polls Azure Key Vault and emits events for secrets whose current version changed.
+type AzureKeyVaultPoll struct {
+	config   *v1alpha1.AzureKeyVaultPollConfig
+	context  context.Context
+	cancel   context.CancelFunc
+	logger   logr.Logger
+	kvClient *azsecrets.Client
+	poller   *poller.Poller
+}
+
+// Start begins polling Azure Key Vault.
+func (h *AzureKeyVaultPoll) Start() error {
+	h.logger.Info("Started polling Azure Key Vault", "vault", h.config.VaultURL, "names", h.config.Names, "tags", h.config.Tags)
+	go h.poller.Run(h.context)
+	return nil
+}
+
+// Stop stops polling Azure Key Vault.
+func (h *AzureKeyVaultPoll) Stop() error {
+	h.cancel()
+	return nil
+}
+
+// fetch returns the current version ID of the watched secrets.
+func (h *AzureKeyVaultPoll) fetch(ctx context.Context) (map[string]string, error) {
+	names := h.config.Names
+	if len(names) == 0 || len(h.config.Tags) > 0 {
+		var err error
+		if names, err = h.listSecrets(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var mu sync.Mutex
+	versions := map[string]string{}
+	err := poller.ForEach(ctx, poller.Concurrency(h.config.Poll), names, func(ctx context.Context, name string) error {
+		version, err := h.currentVersion(ctx, name)
+		if err != nil {
+			return err
+		}
+		if version == "" {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		versions[name] = version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// listSecrets returns the names of the enabled secrets matching Names and Tags.
+func (h *AzureKeyVaultPoll) listSecrets(ctx context.Context) ([]string, error) {
+	names := []string{}
+	pager := h.kvClient.NewListSecretPropertiesPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not list secrets: %w", err)
+		}
+		for _, secret := range page.Value {
+			if secret.ID == nil || !enabled(secret.Attributes) || !h.matchesTags(secret.Tags) {
+				continue
+			}
+			name := secret.ID.Name()
+			if len(h.config.Names) > 0 && !slices.Contains(h.config.Names, name) {
+				continue
+			}
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// currentVersion returns the ID of the most recently created enabled version of a secret,
+// or an empty string if the secret does not exist or has no enabled version.
+func (h *AzureKeyVaultPoll) currentVersion(ctx context.Context, name string) (string, error) {
+	var current *azsecrets.SecretProperties
+	pager := h.kvClient.NewListSecretPropertiesVersionsPager(name, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not list versions of secret %s: %w", name, err)
+		}
+		for _, version := range page.Value {
+			if version.ID == nil || !enabled(version.Attributes) || version.Attributes.Created == nil {
+				continue
+			}
+			if current == nil || version.Attributes.Created.After(*current.Attributes.Created) {
+				current = version
+			}
+		}
+	}
+	if current == nil {
+		return "", nil
+	}
+	return current.ID.Version(), nil
+}
+
+func (h *AzureKeyVaultPoll) matchesTags(tags map[string]*string) bool {
+	for key, value := range h.config.Tags {
+		if tag, ok := tags[key]; !ok || tag == nil || *tag != value {
+			return false
+		}
+	}
+	return true
+}
+
+func enabled(attributes *azsecrets.SecretAttributes) bool {
+	return attributes != nil && attributes.Enabled != nil && *attributes.Enabled
+}
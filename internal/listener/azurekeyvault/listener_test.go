@@ -0,0 +1,158 @@
+package azurekeyvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticCredential struct{}
+
+func (staticCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+type secretVersion struct {
+	id      string
+	enabled bool
+	created int64
+}
+
+// standIn serves the secret listing endpoints of the Key Vault REST API.
+type standIn struct {
+	url      string
+	mu       sync.Mutex
+	secrets  map[string][]secretVersion
+	tags     map[string]map[string]string
+	requests []string
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer authorization="https://login.microsoftonline.com/tenant", resource="https://vault.azure.net"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+
+	value := []map[string]any{}
+	if r.URL.Path == "/secrets" {
+		for name, versions := range s.secrets {
+			value = append(value, map[string]any{
+				"id":         fmt.Sprintf("%s/secrets/%s", s.url, name),
+				"attributes": map[string]any{"enabled": len(versions) > 0},
+				"tags":       s.tags[name],
+			})
+		}
+	} else if name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/secrets/"), "/versions"); ok {
+		versions, found := s.secrets[name]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": "SecretNotFound"}})
+			return
+		}
+		for _, v := range versions {
+			value = append(value, map[string]any{
+				"id":         fmt.Sprintf("%s/secrets/%s/%s", s.url, name, v.id),
+				"attributes": map[string]any{"enabled": v.enabled, "created": v.created},
+			})
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"value": value})
+}
+
+func (s *standIn) add(name string, version secretVersion) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[name] = append(s.secrets[name], version)
+}
+
+func newTestClient(t *testing.T, stand *standIn) *azsecrets.Client {
+	server := httptest.NewTLSServer(stand)
+	t.Cleanup(server.Close)
+	stand.url = server.URL
+	kvClient, err := azsecrets.NewClient(server.URL, staticCredential{}, &azsecrets.ClientOptions{
+		ClientOptions:                        azcore.ClientOptions{Transport: server.Client()},
+		DisableChallengeResourceVerification: true,
+	})
+	require.NoError(t, err)
+	return kvClient
+}
+
+func TestPollDetectsCurrentVersionChanges(t *testing.T) {
+	stand := &standIn{
+		secrets: map[string][]secretVersion{
+			"db":    {{id: "v1", enabled: true, created: 1700000000}, {id: "v0", enabled: true, created: 1600000000}},
+			"api":   {{id: "v1", enabled: true, created: 1700000000}},
+			"other": {{id: "v1", enabled: true, created: 1700000000}},
+		},
+		tags: map[string]map[string]string{
+			"db":    {"team": "payments"},
+			"api":   {"team": "payments"},
+			"other": {"team": "search"},
+		},
+	}
+	kvClient := newTestClient(t, stand)
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	h := &AzureKeyVaultPoll{
+		config:   &v1alpha1.AzureKeyVaultPollConfig{Tags: map[string]string{"team": "payments"}},
+		logger:   logr.Discard(),
+		kvClient: kvClient,
+	}
+	p := poller.New(nil, nil, h.fetch, schema.AZURE_KEY_VAULT_POLL, eventChan, logr.Discard())
+
+	ctx := context.Background()
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+
+	// A newer disabled version is not current.
+	stand.add("api", secretVersion{id: "v2", enabled: false, created: 1800000000})
+	stand.add("db", secretVersion{id: "v2", enabled: true, created: 1800000000})
+	stand.add("other", secretVersion{id: "v2", enabled: true, created: 1800000000})
+	require.NoError(t, p.Poll(ctx))
+	require.Len(t, eventChan, 1)
+	event := <-eventChan
+	assert.Equal(t, "db", event.SecretIdentifier)
+	assert.Equal(t, "AzureKeyVaultPoll", event.TriggerSource)
+
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+}
+
+func TestFetchByNames(t *testing.T) {
+	stand := &standIn{secrets: map[string][]secretVersion{
+		"db":       {{id: "v1", enabled: true, created: 1700000000}, {id: "v2", enabled: true, created: 1800000000}},
+		"disabled": {{id: "v1", enabled: false, created: 1700000000}},
+		"other":    {{id: "v1", enabled: true, created: 1700000000}},
+	}}
+	h := &AzureKeyVaultPoll{
+		config:   &v1alpha1.AzureKeyVaultPollConfig{Names: []string{"db", "disabled", "missing"}},
+		logger:   logr.Discard(),
+		kvClient: newTestClient(t, stand),
+	}
+
+	got, err := h.fetch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"db": "v2"}, got)
+	assert.NotContains(t, stand.requests, "/secrets")
+}
@@ -0,0 +1,55 @@
+package azurekeyvault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/external-secrets-inc/reloader/pkg/auth/azure"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a listener polling Azure Key Vault for new secret versions.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.AzureKeyVaultPoll == nil {
+		return nil, errors.New("AzureKeyVaultPoll config is nil")
+	}
+	cfg := config.AzureKeyVaultPoll
+	if cfg.VaultURL == "" {
+		return nil, errors.New("AzureKeyVaultPoll vaultURL is required")
+	}
+	cred, err := azure.NewTokenCredential(ctx, &cfg.Auth, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not create azure credential: %w", err)
+	}
+	kvClient, err := azsecrets.NewClient(cfg.VaultURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create key vault client: %w", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return newListener(ctx, cancel, cfg, client, kvClient, eventChan, logger), nil
+}
+
+func newListener(ctx context.Context, cancel context.CancelFunc, cfg *v1alpha1.AzureKeyVaultPollConfig, client client.Client, kvClient *azsecrets.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) *AzureKeyVaultPoll {
+	h := &AzureKeyVaultPoll{
+		config:   cfg,
+		context:  ctx,
+		cancel:   cancel,
+		logger:   logger,
+		kvClient: kvClient,
+	}
+	h.poller = poller.New(cfg.Poll, client, h.fetch, schema.AZURE_KEY_VAULT_POLL, eventChan, logger)
+	return h
+}
+
+func init() {
+	schema.RegisterProvider(schema.AZURE_KEY_VAULT_POLL, &Provider{})
+}
@@ -0,0 +1,226 @@
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/listener/webhook"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	structuredContentType = "application/cloudevents+json"
+	batchContentType      = "application/cloudevents-batch+json"
+
+	identifierFromSubject = "subject"
+	identifierFromSource  = "source"
+	identifierFromType    = "type"
+	identifierFromID      = "id"
+	identifierFromData    = "data"
+)
+
+// CloudEvent holds the CloudEvents attributes used by the reloader.
+type CloudEvent struct {
+	SpecVersion string          `json:"specversion"`
+	ID          string          `json:"id"`
+	Source      string          `json:"source"`
+	Type        string          `json:"type"`
+	Subject     string          `json:"subject,omitempty"`
+	Time        string          `json:"time,omitempty"`
+	Data        json.RawMessage `json:"data,omitempty"`
+	DataBase64  string          `json:"data_base64,omitempty"`
+}
+
+type rule struct {
+	config  v1alpha1.CloudEventsRule
+	typ     *regexp.Regexp
+	source  *regexp.Regexp
+	subject *regexp.Regexp
+}
+
+// CloudEvents represents a webhook listener configured to parse CloudEvents.
+type CloudEvents struct {
+	config  *v1alpha1.CloudEventsConfig
+	logger  logr.Logger
+	rules   []rule
+	webhook *webhook.WebhookListener
+}
+
+// Start initiates the CloudEvents listener.
+func (c *CloudEvents) Start() error {
+	return c.webhook.Start()
+}
+
+// Stop gracefully shuts down the CloudEvents listener.
+func (c *CloudEvents) Stop() error {
+	return c.webhook.Stop()
+}
+
+// handshake answers the CloudEvents webhook abuse protection validation request.
+func (c *CloudEvents) handshake(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("WebHook-Request-Origin")
+	if origin == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "Missing WebHook-Request-Origin header")
+		return
+	}
+	if len(c.config.AllowedOrigins) > 0 && !slices.Contains(c.config.AllowedOrigins, origin) {
+		c.logger.Info("Rejected CloudEvents handshake", "origin", origin)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Allow", http.MethodPost)
+	w.Header().Set("WebHook-Allowed-Origin", origin)
+	if c.config.AllowedRate != nil {
+		w.Header().Set("WebHook-Allowed-Rate", strconv.Itoa(int(*c.config.AllowedRate)))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// extract parses binary, structured and batch CloudEvents and maps them to rotation events.
+func (c *CloudEvents) extract(header http.Header, body string) ([]events.SecretRotationEvent, error) {
+	cloudEvents, err := parseCloudEvents(header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	rotationEvents := []events.SecretRotationEvent{}
+	for _, ce := range cloudEvents {
+		if ce.ID == "" || ce.Source == "" || ce.Type == "" || ce.SpecVersion == "" {
+			return nil, errors.New("cloudevent is missing required attributes id, source, type or specversion")
+		}
+		r := c.match(ce)
+		if r == nil {
+			c.logger.V(1).Info("Ignoring CloudEvent not matching any rule", "id", ce.ID, "type", ce.Type, "source", ce.Source)
+			continue
+		}
+		ruleEvents, err := toRotationEvents(ce, r)
+		if err != nil {
+			return nil, fmt.Errorf("could not map cloudevent %s: %w", ce.ID, err)
+		}
+		rotationEvents = append(rotationEvents, ruleEvents...)
+	}
+	return rotationEvents, nil
+}
+
+func (c *CloudEvents) match(ce CloudEvent) *v1alpha1.CloudEventsRule {
+	if len(c.rules) == 0 {
+		return &v1alpha1.CloudEventsRule{IdentifierFrom: identifierFromSubject}
+	}
+	for _, r := range c.rules {
+		if matches(r.typ, ce.Type) && matches(r.source, ce.Source) && matches(r.subject, ce.Subject) {
+			return &r.config
+		}
+	}
+	return nil
+}
+
+func matches(re *regexp.Regexp, value string) bool {
+	return re == nil || re.MatchString(value)
+}
+
+func parseCloudEvents(header http.Header, body string) ([]CloudEvent, error) {
+	contentType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case contentType == batchContentType:
+		var batch []CloudEvent
+		if err := json.Unmarshal([]byte(body), &batch); err != nil {
+			return nil, fmt.Errorf("could not decode cloudevents batch: %w", err)
+		}
+		return batch, nil
+	case contentType == structuredContentType:
+		var ce CloudEvent
+		if err := json.Unmarshal([]byte(body), &ce); err != nil {
+			return nil, fmt.Errorf("could not decode structured cloudevent: %w", err)
+		}
+		return []CloudEvent{ce}, nil
+	case header.Get("ce-specversion") != "":
+		return []CloudEvent{{
+			SpecVersion: header.Get("ce-specversion"),
+			ID:          header.Get("ce-id"),
+			Source:      header.Get("ce-source"),
+			Type:        header.Get("ce-type"),
+			Subject:     header.Get("ce-subject"),
+			Time:        header.Get("ce-time"),
+			Data:        json.RawMessage(body),
+		}}, nil
+	default:
+		return nil, errors.New("request is not a cloudevent")
+	}
+}
+
+func toRotationEvents(ce CloudEvent, r *v1alpha1.CloudEventsRule) ([]events.SecretRotationEvent, error) {
+	triggerSource := fmt.Sprintf("%s/%s", schema.CLOUD_EVENTS, ce.Type)
+	timestamp := ce.Time
+	if timestamp == "" {
+		timestamp = time.Now().Format(payload.TimestampFormat)
+	}
+	data, err := eventData(ce)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifier string
+	switch r.IdentifierFrom {
+	case identifierFromSubject, "":
+		identifier = ce.Subject
+	case identifierFromSource:
+		identifier = ce.Source
+	case identifierFromType:
+		identifier = ce.Type
+	case identifierFromID:
+		identifier = ce.ID
+	case identifierFromData:
+		rotationEvents, err := payload.ExtractEvents(data, payload.Paths{
+			Identifier: r.IdentifierPathOnData,
+			Namespace:  r.NamespacePathOnData,
+		}, triggerSource)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rotationEvents {
+			rotationEvents[i].RotationTimestamp = timestamp
+		}
+		return rotationEvents, nil
+	default:
+		return nil, fmt.Errorf("unsupported identifierFrom %s", r.IdentifierFrom)
+	}
+	if identifier == "" {
+		return nil, fmt.Errorf("cloudevent attribute %s is empty", r.IdentifierFrom)
+	}
+
+	event := events.SecretRotationEvent{
+		SecretIdentifier:  identifier,
+		RotationTimestamp: timestamp,
+		TriggerSource:     triggerSource,
+	}
+	if r.NamespacePathOnData != "" {
+		event.Namespace = gjson.Get(data, r.NamespacePathOnData).String()
+	}
+	return []events.SecretRotationEvent{event}, nil
+}
+
+func eventData(ce CloudEvent) (string, error) {
+	if ce.DataBase64 != "" {
+		data, err := base64.StdEncoding.DecodeString(ce.DataBase64)
+		if err != nil {
+			return "", fmt.Errorf("could not decode data_base64: %w", err)
+		}
+		return string(data), nil
+	}
+	return string(ce.Data), nil
+}
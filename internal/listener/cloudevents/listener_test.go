@@ -0,0 +1,113 @@
+package cloudevents
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	rules, err := compileRules([]v1alpha1.CloudEventsRule{
+		{Type: `^com\.example\.secret\.rotated$`, IdentifierFrom: "data", IdentifierPathOnData: "secrets", NamespacePathOnData: "namespace"},
+		{Source: `^/vault/`, IdentifierFrom: "subject"},
+	})
+	require.NoError(t, err)
+	c := &CloudEvents{config: &v1alpha1.CloudEventsConfig{}, logger: logr.Discard(), rules: rules}
+
+	testCases := []struct {
+		name            string
+		header          http.Header
+		body            string
+		wantIdentifiers []string
+		wantNamespace   string
+		wantSource      string
+		wantErr         bool
+	}{
+		{
+			name: "binary mode",
+			header: http.Header{
+				"Content-Type":   {"application/json"},
+				"Ce-Specversion": {"1.0"},
+				"Ce-Id":          {"1"},
+				"Ce-Source":      {"/rotator"},
+				"Ce-Type":        {"com.example.secret.rotated"},
+				"Ce-Time":        {"2024-09-19T12:00:00Z"},
+			},
+			body:            `{"secrets":["db","api"],"namespace":"default"}`,
+			wantIdentifiers: []string{"db", "api"},
+			wantNamespace:   "default",
+			wantSource:      "CloudEvents/com.example.secret.rotated",
+		},
+		{
+			name:            "structured mode",
+			header:          http.Header{"Content-Type": {"application/cloudevents+json; charset=utf-8"}},
+			body:            `{"specversion":"1.0","id":"2","source":"/vault/kv","type":"kv.write","subject":"app/db"}`,
+			wantIdentifiers: []string{"app/db"},
+			wantSource:      "CloudEvents/kv.write",
+		},
+		{
+			name:   "batch mode ignores unmatched events",
+			header: http.Header{"Content-Type": {"application/cloudevents-batch+json"}},
+			body: `[{"specversion":"1.0","id":"3","source":"/vault/kv","type":"kv.write","subject":"a"},
+				{"specversion":"1.0","id":"4","source":"/other","type":"other","subject":"b"},
+				{"specversion":"1.0","id":"5","source":"/vault/kv","type":"kv.write","subject":"c"}]`,
+			wantIdentifiers: []string{"a", "c"},
+			wantSource:      "CloudEvents/kv.write",
+		},
+		{
+			name:    "missing required attributes",
+			header:  http.Header{"Content-Type": {"application/cloudevents+json"}},
+			body:    `{"specversion":"1.0","source":"/vault/kv","subject":"a"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not a cloudevent",
+			header:  http.Header{"Content-Type": {"application/json"}},
+			body:    `{}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := c.extract(tc.header, tc.body)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, len(tc.wantIdentifiers))
+			for i, event := range got {
+				assert.Equal(t, tc.wantIdentifiers[i], event.SecretIdentifier)
+				assert.Equal(t, tc.wantNamespace, event.Namespace)
+				assert.Equal(t, tc.wantSource, event.TriggerSource)
+			}
+		})
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	rate := int32(120)
+	c := &CloudEvents{
+		config: &v1alpha1.CloudEventsConfig{AllowedOrigins: []string{"eventemitter.example.com"}, AllowedRate: &rate},
+		logger: logr.Discard(),
+	}
+
+	req := httptest.NewRequest(http.MethodOptions, "/cloudevents", nil)
+	req.Header.Set("WebHook-Request-Origin", "eventemitter.example.com")
+	rec := httptest.NewRecorder()
+	c.handshake(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "eventemitter.example.com", rec.Header().Get("WebHook-Allowed-Origin"))
+	assert.Equal(t, "120", rec.Header().Get("WebHook-Allowed-Rate"))
+
+	req.Header.Set("WebHook-Request-Origin", "unknown.example.com")
+	rec = httptest.NewRecorder()
+	c.handshake(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,92 @@
+package cloudevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/listener/webhook"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultPath = "/cloudevents"
+const defaultServerAddress = ":8091"
+
+type Provider struct{}
+
+// CreateListener creates a webhook listener configured to parse CloudEvents.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.CloudEvents == nil {
+		return nil, errors.New("CloudEvents config is nil")
+	}
+	rules, err := compileRules(config.CloudEvents.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookConfig := &v1alpha1.WebhookConfig{
+		Path:        config.CloudEvents.Path,
+		Address:     config.CloudEvents.Address,
+		Auth:        config.CloudEvents.Auth,
+		RetryPolicy: config.CloudEvents.RetryPolicy,
+	}
+	if webhookConfig.Path == "" {
+		webhookConfig.Path = defaultPath
+	}
+	if webhookConfig.Address == "" {
+		webhookConfig.Address = defaultServerAddress
+	}
+	wh, err := webhook.NewWebhookListener(ctx, webhookConfig, client, eventChan, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CloudEvents{
+		config:  config.CloudEvents,
+		logger:  logger,
+		rules:   rules,
+		webhook: wh,
+	}
+	wh.SetExtractFn(c.extract)
+	wh.HandleFunc(fmt.Sprintf("%s %s", http.MethodOptions, wh.Path()), c.handshake)
+	return c, nil
+}
+
+func compileRules(configs []v1alpha1.CloudEventsRule) ([]rule, error) {
+	rules := make([]rule, 0, len(configs))
+	for _, cfg := range configs {
+		if cfg.IdentifierFrom == identifierFromData && cfg.IdentifierPathOnData == "" {
+			return nil, errors.New("identifierPathOnData is required when identifierFrom is data")
+		}
+		r := rule{config: cfg}
+		var err error
+		if r.typ, err = compile(cfg.Type); err != nil {
+			return nil, fmt.Errorf("invalid type expression: %w", err)
+		}
+		if r.source, err = compile(cfg.Source); err != nil {
+			return nil, fmt.Errorf("invalid source expression: %w", err)
+		}
+		if r.subject, err = compile(cfg.Subject); err != nil {
+			return nil, fmt.Errorf("invalid subject expression: %w", err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func compile(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+func init() {
+	schema.RegisterProvider(schema.CLOUD_EVENTS, &Provider{})
+}
@@ -0,0 +1,119 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+	"google.golang.org/api/iterator"
+)
+
+// GoogleSecretManagerPoll polls GCP Secret Manager and emits events for secrets whose latest enabled version changed.
+type GoogleSecretManagerPoll struct {
+	config   *v1alpha1.GoogleSecretManagerPollConfig
+	context  context.Context
+	cancel   context.CancelFunc
+	logger   logr.Logger
+	smClient *secretmanager.Client
+	poller   *poller.Poller
+}
+
+// Start begins polling GCP Secret Manager.
+func (h *GoogleSecretManagerPoll) Start() error {
+	h.logger.Info("Started polling GCP Secret Manager", "project", h.config.ProjectID, "filter", h.filter())
+	go h.poller.Run(h.context)
+	return nil
+}
+
+// Stop stops polling GCP Secret Manager.
+func (h *GoogleSecretManagerPoll) Stop() error {
+	h.cancel()
+	return h.smClient.Close()
+}
+
+// fetch lists the watched secrets and returns the name of their latest enabled version.
+func (h *GoogleSecretManagerPoll) fetch(ctx context.Context) (map[string]string, error) {
+	secrets := []string{}
+	it := h.smClient.ListSecrets(ctx, &secretmanagerpb.ListSecretsRequest{
+		Parent: fmt.Sprintf("projects/%s", h.config.ProjectID),
+		Filter: h.filter(),
+	})
+	for {
+		secret, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not list secrets: %w", err)
+		}
+		if strings.HasPrefix(secretID(secret.Name), h.config.NamePrefix) {
+			secrets = append(secrets, secret.Name)
+		}
+	}
+
+	var mu sync.Mutex
+	versions := map[string]string{}
+	err := poller.ForEach(ctx, poller.Concurrency(h.config.Poll), secrets, func(ctx context.Context, secret string) error {
+		version, err := h.latestEnabledVersion(ctx, secret)
+		if err != nil {
+			return err
+		}
+		if version == "" {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		versions[secretID(secret)] = version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// latestEnabledVersion returns the newest enabled version of a secret, or an empty string if it has none.
+// Versions are listed newest first.
+func (h *GoogleSecretManagerPoll) latestEnabledVersion(ctx context.Context, secret string) (string, error) {
+	it := h.smClient.ListSecretVersions(ctx, &secretmanagerpb.ListSecretVersionsRequest{
+		Parent:   secret,
+		Filter:   "state:ENABLED",
+		PageSize: 1,
+	})
+	version, err := it.Next()
+	if errors.Is(err, iterator.Done) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not list versions of secret %s: %w", secret, err)
+	}
+	return version.Name, nil
+}
+
+func (h *GoogleSecretManagerPoll) filter() string {
+	filters := []string{}
+	for _, key := range slices.Sorted(maps.Keys(h.config.Labels)) {
+		filters = append(filters, fmt.Sprintf("labels.%s=%s", key, h.config.Labels[key]))
+	}
+	if h.config.NamePrefix != "" {
+		filters = append(filters, fmt.Sprintf("name:%s", h.config.NamePrefix))
+	}
+	if h.config.Filter != "" {
+		filters = append(filters, fmt.Sprintf("(%s)", h.config.Filter))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// secretID returns the secret ID of a `projects/<project>/secrets/<id>` resource name.
+func secretID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
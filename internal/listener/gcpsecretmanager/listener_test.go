@@ -0,0 +1,104 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeSecretManager struct {
+	secretmanagerpb.UnimplementedSecretManagerServiceServer
+	mu       sync.Mutex
+	filters  []string
+	versions map[string][]string
+}
+
+func (f *fakeSecretManager) ListSecrets(_ context.Context, req *secretmanagerpb.ListSecretsRequest) (*secretmanagerpb.ListSecretsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.filters = append(f.filters, req.Filter)
+	resp := &secretmanagerpb.ListSecretsResponse{}
+	for name := range f.versions {
+		if strings.Contains(name, "app-") {
+			resp.Secrets = append(resp.Secrets, &secretmanagerpb.Secret{Name: req.Parent + "/secrets/" + name})
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeSecretManager) ListSecretVersions(_ context.Context, req *secretmanagerpb.ListSecretVersionsRequest) (*secretmanagerpb.ListSecretVersionsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := secretID(req.Parent)
+	resp := &secretmanagerpb.ListSecretVersionsResponse{}
+	for _, version := range f.versions[name] {
+		resp.Versions = append(resp.Versions, &secretmanagerpb.SecretVersion{Name: req.Parent + "/versions/" + version})
+	}
+	return resp, nil
+}
+
+func (f *fakeSecretManager) set(name string, versions ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.versions[name] = versions
+}
+
+func TestPollDetectsNewVersions(t *testing.T) {
+	fake := &fakeSecretManager{versions: map[string][]string{
+		"app-db":      {"1"},
+		"app-api":     {"2", "1"},
+		"not-app-old": {},
+		"other":       {"1"},
+	}}
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	secretmanagerpb.RegisterSecretManagerServiceServer(server, fake)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	ctx := context.Background()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	smClient, err := secretmanager.NewClient(ctx, option.WithGRPCConn(conn))
+	require.NoError(t, err)
+
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	h := newListener(ctx, func() {}, &v1alpha1.GoogleSecretManagerPollConfig{
+		ProjectID:  "my-project",
+		NamePrefix: "app-",
+		Labels:     map[string]string{"team": "payments", "env": "prod"},
+	}, nil, smClient, eventChan, logr.Discard())
+
+	versions, err := h.fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"app-db":  "projects/my-project/secrets/app-db/versions/1",
+		"app-api": "projects/my-project/secrets/app-api/versions/2",
+	}, versions)
+	assert.Equal(t, "labels.env=prod AND labels.team=payments AND name:app-", fake.filters[0])
+
+	require.NoError(t, h.poller.Poll(ctx))
+	assert.Empty(t, eventChan)
+	fake.set("app-db", "2", "1")
+	require.NoError(t, h.poller.Poll(ctx))
+	require.Len(t, eventChan, 1)
+	event := <-eventChan
+	assert.Equal(t, "app-db", event.SecretIdentifier)
+	assert.Equal(t, "GoogleSecretManagerPoll", event.TriggerSource)
+}
@@ -0,0 +1,59 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/external-secrets-inc/reloader/pkg/auth/gcp"
+	"github.com/go-logr/logr"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a listener polling GCP Secret Manager for new secret versions.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.GoogleSecretManagerPoll == nil {
+		return nil, errors.New("GoogleSecretManagerPoll config is nil")
+	}
+	cfg := config.GoogleSecretManagerPoll
+	if cfg.ProjectID == "" {
+		return nil, errors.New("GoogleSecretManagerPoll projectID is required")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	ts, err := gcp.NewTokenSource(ctx, cfg.Auth, cfg.ProjectID, client)
+	if err != nil {
+		defer cancel()
+		return nil, fmt.Errorf("could not create token source: %w", err)
+	}
+	smClient, err := secretmanager.NewClient(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		defer cancel()
+		return nil, fmt.Errorf("could not create secret manager client: %w", err)
+	}
+	return newListener(ctx, cancel, cfg, client, smClient, eventChan, logger), nil
+}
+
+func newListener(ctx context.Context, cancel context.CancelFunc, cfg *v1alpha1.GoogleSecretManagerPollConfig, client client.Client, smClient *secretmanager.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) *GoogleSecretManagerPoll {
+	h := &GoogleSecretManagerPoll{
+		config:   cfg,
+		context:  ctx,
+		cancel:   cancel,
+		logger:   logger,
+		smClient: smClient,
+	}
+	h.poller = poller.New(cfg.Poll, client, h.fetch, schema.GOOGLE_SECRET_MANAGER_POLL, eventChan, logger)
+	return h
+}
+
+func init() {
+	schema.RegisterProvider(schema.GOOGLE_SECRET_MANAGER_POLL, &Provider{})
+}
@@ -0,0 +1,267 @@
+package hashivaultpoll
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/go-logr/logr"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultMountPath           = "secret"
+	defaultKubernetesMountPath = "kubernetes"
+
+	requestTimeout = 30 * time.Second
+	// tokenExpiryMargin is how long before its expiry a Vault token is replaced by a new login.
+	tokenExpiryMargin = time.Minute
+)
+
+// errPermissionDenied is returned when Vault rejects the token of a request.
+var errPermissionDenied = errors.New("permission denied")
+
+// HashicorpVaultPoll polls the metadata of Vault KV v2 secrets and emits events for secrets whose version changed.
+type HashicorpVaultPoll struct {
+	config     *v1alpha1.HashicorpVaultPollConfig
+	context    context.Context
+	cancel     context.CancelFunc
+	client     client.Client
+	clientSet  typedcorev1.CoreV1Interface
+	httpClient *http.Client
+	logger     logr.Logger
+	poller     *poller.Poller
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+type vaultResponse struct {
+	Data struct {
+		Keys           []string `json:"keys"`
+		CurrentVersion int      `json:"current_version"`
+		UpdatedTime    string   `json:"updated_time"`
+	} `json:"data"`
+	Auth *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// Start begins polling Vault.
+func (h *HashicorpVaultPoll) Start() error {
+	h.logger.Info("Started polling Vault KV metadata", "address", h.config.Address, "mount", h.mountPath(), "paths", h.config.Paths)
+	go h.poller.Run(h.context)
+	return nil
+}
+
+// Stop stops polling Vault.
+func (h *HashicorpVaultPoll) Stop() error {
+	h.cancel()
+	h.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// fetch lists the watched secrets and returns their `<current_version>@<updated_time>`.
+func (h *HashicorpVaultPoll) fetch(ctx context.Context) (map[string]string, error) {
+	token, err := h.vaultToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := poller.Concurrency(h.config.Poll)
+
+	folders := []string{}
+	secrets := []string{}
+	for _, path := range h.config.Paths {
+		path = strings.TrimPrefix(path, "/")
+		if path == "" || strings.HasSuffix(path, "/") {
+			folders = append(folders, path)
+		} else {
+			secrets = append(secrets, path)
+		}
+	}
+
+	var mu sync.Mutex
+	for len(folders) > 0 {
+		subFolders := []string{}
+		err := poller.ForEach(ctx, concurrency, folders, func(ctx context.Context, folder string) error {
+			keys, err := h.list(ctx, token, folder)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, key := range keys {
+				if !strings.HasSuffix(key, "/") {
+					secrets = append(secrets, folder+key)
+				} else if h.config.Recursive {
+					subFolders = append(subFolders, folder+key)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		folders = subFolders
+	}
+	slices.Sort(secrets)
+	secrets = slices.Compact(secrets)
+
+	versions := map[string]string{}
+	err = poller.ForEach(ctx, concurrency, secrets, func(ctx context.Context, secret string) error {
+		resp, found, err := h.do(ctx, http.MethodGet, h.metadataPath(secret), token, nil)
+		if err != nil {
+			return fmt.Errorf("could not read metadata of %s: %w", secret, err)
+		}
+		if !found {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		versions[secret] = fmt.Sprintf("%d@%s", resp.Data.CurrentVersion, resp.Data.UpdatedTime)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// list returns the keys of a metadata folder. Folders end with `/`.
+func (h *HashicorpVaultPoll) list(ctx context.Context, token, folder string) ([]string, error) {
+	resp, found, err := h.do(ctx, http.MethodGet, h.metadataPath(folder)+"?list=true", token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", folder, err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return resp.Data.Keys, nil
+}
+
+func (h *HashicorpVaultPoll) metadataPath(path string) string {
+	p := fmt.Sprintf("%s/metadata/%s", strings.Trim(h.mountPath(), "/"), path)
+	return (&url.URL{Path: p}).EscapedPath()
+}
+
+// vaultToken returns the current Vault token, logging in again if it is missing or about to expire.
+func (h *HashicorpVaultPoll) vaultToken(ctx context.Context) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.token != "" && (h.tokenExpiry.IsZero() || time.Now().Before(h.tokenExpiry)) {
+		return h.token, nil
+	}
+
+	if h.config.Auth.TokenRef != nil {
+		token, err := resolvers.SecretKeyRef(ctx, h.client, h.config.Auth.TokenRef)
+		if err != nil {
+			return "", fmt.Errorf("could not get vault token: %w", err)
+		}
+		h.token, h.tokenExpiry = token, time.Time{}
+		return h.token, nil
+	}
+
+	auth := h.config.Auth.Kubernetes
+	if h.clientSet == nil {
+		return "", errors.New("client set is nil")
+	}
+	jwt, err := resolvers.ServiceAccountToken(ctx, h.clientSet, &auth.ServiceAccountRef, time.Hour)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch Auth.ServiceAccount: %w", err)
+	}
+	mountPath := auth.MountPath
+	if mountPath == "" {
+		mountPath = defaultKubernetesMountPath
+	}
+	resp, _, err := h.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", strings.Trim(mountPath, "/")), "", map[string]string{
+		"role": auth.Role,
+		"jwt":  jwt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not log in to vault: %w", err)
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return "", errors.New("vault login did not return a token")
+	}
+	h.token, h.tokenExpiry = resp.Auth.ClientToken, time.Time{}
+	if resp.Auth.LeaseDuration > 0 {
+		h.tokenExpiry = time.Now().Add(time.Duration(resp.Auth.LeaseDuration)*time.Second - tokenExpiryMargin)
+	}
+	return h.token, nil
+}
+
+// resetToken forgets the current token so that the next poll logs in again.
+func (h *HashicorpVaultPoll) resetToken() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.token = ""
+}
+
+// do sends a request to the Vault HTTP API. It returns false if Vault answered 404.
+func (h *HashicorpVaultPoll) do(ctx context.Context, method, path, token string, body any) (*vaultResponse, bool, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, false, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(h.config.Address, "/")+"/v1/"+path, reqBody)
+	if err != nil {
+		return nil, false, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if h.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", h.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	resp := &vaultResponse{}
+	if err := json.NewDecoder(res.Body).Decode(resp); err != nil && !errors.Is(err, io.EOF) {
+		return nil, false, fmt.Errorf("could not decode vault response: %w", err)
+	}
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return resp, false, nil
+	case res.StatusCode == http.StatusForbidden && token != "":
+		h.resetToken()
+		return nil, false, fmt.Errorf("%w: %s", errPermissionDenied, strings.Join(resp.Errors, "; "))
+	case res.StatusCode >= http.StatusBadRequest:
+		return nil, false, fmt.Errorf("vault returned %d: %s", res.StatusCode, strings.Join(resp.Errors, "; "))
+	}
+	return resp, true, nil
+}
+
+func (h *HashicorpVaultPoll) mountPath() string {
+	if h.config.MountPath == "" {
+		return defaultMountPath
+	}
+	return h.config.MountPath
+}
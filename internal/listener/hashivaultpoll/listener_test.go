@@ -0,0 +1,226 @@
+package hashivaultpoll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeVault serves the KV v2 metadata and Kubernetes login endpoints of the Vault HTTP API.
+type fakeVault struct {
+	mu       sync.Mutex
+	token    string
+	logins   []map[string]string
+	secrets  map[string]int
+	requests []string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/v1/auth/kubernetes/login" {
+		login := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&login)
+		v.logins = append(v.logins, login)
+		_ = json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": v.token, "lease_duration": 3600}})
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+	path, ok := strings.CutPrefix(r.URL.Path, "/v1/secret/metadata/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	v.requests = append(v.requests, r.URL.RequestURI())
+
+	if r.URL.Query().Get("list") == "true" {
+		keys := map[string]bool{}
+		for secret := range v.secrets {
+			if rest, ok := strings.CutPrefix(secret, path); ok {
+				if i := strings.Index(rest, "/"); i >= 0 {
+					rest = rest[:i+1]
+				}
+				keys[rest] = true
+			}
+		}
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{}})
+			return
+		}
+		list := []string{}
+		for key := range keys {
+			list = append(list, key)
+		}
+		sort.Strings(list)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"keys": list}})
+		return
+	}
+	version, ok := v.secrets[path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{}})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+		"current_version": version,
+		"updated_time":    "2024-09-19T12:00:00Z",
+	}})
+}
+
+func (v *fakeVault) set(secret string, version int) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.secrets[secret] = version
+}
+
+func TestFetch(t *testing.T) {
+	vault := &fakeVault{token: "token", secrets: map[string]int{
+		"app/db":            1,
+		"app/nested/api":    2,
+		"app/nested/x/deep": 3,
+		"other/cache":       4,
+		"top":               5,
+	}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("token")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+
+	testCases := []struct {
+		name      string
+		paths     []string
+		recursive bool
+		want      map[string]string
+	}{
+		{
+			name:  "folder",
+			paths: []string{"app/"},
+			want:  map[string]string{"app/db": "1@2024-09-19T12:00:00Z"},
+		},
+		{
+			name:      "recursive folder",
+			paths:     []string{"app/"},
+			recursive: true,
+			want: map[string]string{
+				"app/db":            "1@2024-09-19T12:00:00Z",
+				"app/nested/api":    "2@2024-09-19T12:00:00Z",
+				"app/nested/x/deep": "3@2024-09-19T12:00:00Z",
+			},
+		},
+		{
+			name:  "root and single secrets",
+			paths: []string{"", "other/cache", "missing"},
+			want: map[string]string{
+				"top":         "5@2024-09-19T12:00:00Z",
+				"other/cache": "4@2024-09-19T12:00:00Z",
+			},
+		},
+		{
+			name:  "missing folder",
+			paths: []string{"missing/"},
+			want:  map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &v1alpha1.HashicorpVaultPollConfig{
+				Address:   server.URL,
+				Paths:     tc.paths,
+				Recursive: tc.recursive,
+				Auth: v1alpha1.HashicorpVaultPollAuth{
+					TokenRef: &v1alpha1.SecretKeySelector{Name: "vault", Namespace: "default", Key: "token"},
+				},
+			}
+			h := newListener(context.Background(), func() {}, cfg, c, nil, server.Client(), nil, logr.Discard())
+			got, err := h.fetch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPollWithKubernetesAuth(t *testing.T) {
+	vault := &fakeVault{token: "token", secrets: map[string]int{"app/db": 1, "app/api": 1}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	clientSet := k8sfake.NewClientset()
+	clientSet.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create := action.(k8stesting.CreateAction)
+		if create.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		tr := create.GetObject().(*authenticationv1.TokenRequest)
+		assert.Equal(t, []string{"vault"}, tr.Spec.Audiences)
+		tr.Status.Token = "jwt"
+		return true, tr, nil
+	})
+
+	cfg := &v1alpha1.HashicorpVaultPollConfig{
+		Address: server.URL,
+		Paths:   []string{"app/"},
+		Auth: v1alpha1.HashicorpVaultPollAuth{
+			Kubernetes: &v1alpha1.HashicorpVaultKubernetesAuth{
+				Role:              "reloader",
+				ServiceAccountRef: v1alpha1.ServiceAccountSelector{Name: "reloader", Namespace: "default", Audiences: []string{"vault"}},
+			},
+		},
+	}
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	h := newListener(context.Background(), func() {}, cfg, nil, clientSet.CoreV1(), server.Client(), eventChan, logr.Discard())
+	p := poller.New(nil, nil, h.fetch, schema.HASHICORP_VAULT_POLL, eventChan, logr.Discard())
+
+	ctx := context.Background()
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+	assert.Equal(t, []map[string]string{{"role": "reloader", "jwt": "jwt"}}, vault.logins)
+
+	vault.set("app/db", 2)
+	require.NoError(t, p.Poll(ctx))
+	require.Len(t, eventChan, 1)
+	event := <-eventChan
+	assert.Equal(t, "app/db", event.SecretIdentifier)
+	assert.Equal(t, "HashicorpVaultPoll", event.TriggerSource)
+	assert.Len(t, vault.logins, 1)
+
+	// A revoked token fails the poll and the next one logs in again.
+	vault.mu.Lock()
+	vault.token = "rotated"
+	vault.mu.Unlock()
+	err := p.Poll(ctx)
+	assert.ErrorIs(t, err, errPermissionDenied)
+	require.NoError(t, p.Poll(ctx))
+	assert.Len(t, vault.logins, 2)
+	assert.Empty(t, eventChan)
+}
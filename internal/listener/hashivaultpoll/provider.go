@@ -0,0 +1,74 @@
+package hashivaultpoll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/poller"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a listener polling the metadata of HashiCorp Vault KV v2 secrets.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.HashicorpVaultPoll == nil {
+		return nil, errors.New("HashicorpVaultPoll config is nil")
+	}
+	cfg := config.HashicorpVaultPoll
+	if cfg.Address == "" {
+		return nil, errors.New("HashicorpVaultPoll address is required")
+	}
+	if (cfg.Auth.Kubernetes == nil) == (cfg.Auth.TokenRef == nil) {
+		return nil, errors.New("exactly one of HashicorpVaultPoll auth.kubernetes or auth.tokenSecretRef must be set")
+	}
+
+	tlsConfig, err := tlsconfig.Build(ctx, client, cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("could not build TLS config: %w", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	var clientSet typedcorev1.CoreV1Interface
+	if cfg.Auth.Kubernetes != nil {
+		restConfig, err := ctrl.GetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("could not get kubernetes config: %w", err)
+		}
+		clientSet, err = typedcorev1.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not create client set: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return newListener(ctx, cancel, cfg, client, clientSet, &http.Client{Transport: transport, Timeout: requestTimeout}, eventChan, logger), nil
+}
+
+func newListener(ctx context.Context, cancel context.CancelFunc, cfg *v1alpha1.HashicorpVaultPollConfig, client client.Client, clientSet typedcorev1.CoreV1Interface, httpClient *http.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) *HashicorpVaultPoll {
+	h := &HashicorpVaultPoll{
+		config:     cfg,
+		context:    ctx,
+		cancel:     cancel,
+		client:     client,
+		clientSet:  clientSet,
+		httpClient: httpClient,
+		logger:     logger,
+	}
+	h.poller = poller.New(cfg.Poll, client, h.fetch, schema.HASHICORP_VAULT_POLL, eventChan, logger)
+	return h
+}
+
+func init() {
+	schema.RegisterProvider(schema.HASHICORP_VAULT_POLL, &Provider{})
+}
@@ -1,4 +1,4 @@
-package k8ssecret
+package k8sconfigmap
 
 import (
 	"context"
@@ -16,7 +16,7 @@ import (
 
 type Provider struct{}
 
-// CreateListener creates a Kubernetes ConfigMap Listener
+// CreateListener creates a Kubernetes ConfigMap Listener.
 func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
 	if config == nil || config.KubernetesConfigMap == nil {
 		return nil, errors.New("KubernetesConfigMap config is nil")
@@ -28,14 +28,15 @@ func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.Notifica
 			Auth:          config.KubernetesConfigMap.Auth,
 			LabelSelector: config.KubernetesConfigMap.LabelSelector,
 		},
-		Ctx:        ctx,
-		Cancel:     cancel,
-		Client:     client,
-		EventChan:  eventChan,
-		Logger:     logger,
-		VersionMap: sync.Map{},
-		Obj:        &corev1.ConfigMap{},
-		Name:       "configmap",
+		Ctx:           ctx,
+		Cancel:        cancel,
+		Client:        client,
+		EventChan:     eventChan,
+		Logger:        logger,
+		VersionMap:    sync.Map{},
+		Obj:           &corev1.ConfigMap{},
+		Name:          "configmap",
+		TriggerSource: schema.KUBERNETES_CONFIG_MAP,
 	}
 
 	return h, nil
@@ -0,0 +1,61 @@
+package k8sobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/kubernetes"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a Kubernetes Listener watching unstructured objects of the configured kind.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.KubernetesObject == nil {
+		return nil, errors.New("KubernetesObject config is nil")
+	}
+	cfg := config.KubernetesObject
+	if cfg.Version == "" || cfg.Kind == "" {
+		return nil, errors.New("KubernetesObject version and kind are required")
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(k8sschema.GroupVersionKind{Group: cfg.Group, Version: cfg.Version, Kind: cfg.Kind})
+
+	ctx, cancel := context.WithCancel(ctx)
+	h := &kubernetes.Handler[*unstructured.Unstructured]{
+		Config: &v1alpha1.KubernetesObjectConfig{
+			ServerURL:     cfg.ServerURL,
+			Auth:          cfg.Auth,
+			LabelSelector: cfg.LabelSelector,
+			Namespace:     cfg.Namespace,
+		},
+		Ctx:           ctx,
+		Cancel:        cancel,
+		Client:        client,
+		EventChan:     eventChan,
+		Logger:        logger,
+		VersionMap:    sync.Map{},
+		Obj:           obj,
+		Name:          strings.ToLower(strings.TrimSuffix(fmt.Sprintf("%s.%s", cfg.Kind, cfg.Group), ".")),
+		TriggerSource: fmt.Sprintf("%s/%s", schema.KUBERNETES_OBJECT, cfg.Kind),
+	}
+	if cfg.FieldPath != "" {
+		h.VersionFn = kubernetes.FieldVersion(cfg.FieldPath)
+	}
+
+	return h, nil
+}
+
+func init() {
+	schema.RegisterProvider(schema.KUBERNETES_OBJECT, &Provider{})
+}
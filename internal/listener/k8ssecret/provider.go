@@ -2,7 +2,11 @@ package k8ssecret
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"sync"
 
 	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
@@ -24,23 +28,59 @@ func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.Notifica
 	ctx, cancel := context.WithCancel(ctx)
 	h := &kubernetes.Handler[*corev1.Secret]{
 		Config: &v1alpha1.KubernetesObjectConfig{
-			ServerURL:     config.KubernetesSecret.ServerURL,
-			Auth:          config.KubernetesSecret.Auth,
-			LabelSelector: config.KubernetesSecret.LabelSelector,
+			ServerURL:         config.KubernetesSecret.ServerURL,
+			Auth:              config.KubernetesSecret.Auth,
+			LabelSelector:     config.KubernetesSecret.LabelSelector,
+			StateConfigMapRef: config.KubernetesSecret.StateConfigMapRef,
 		},
-		Ctx:        ctx,
-		Cancel:     cancel,
-		Client:     client,
-		EventChan:  eventChan,
-		Logger:     logger,
-		VersionMap: sync.Map{},
-		Obj:        &corev1.Secret{},
-		Name:       "secret",
+		Ctx:           ctx,
+		Cancel:        cancel,
+		Client:        client,
+		EventChan:     eventChan,
+		Logger:        logger,
+		VersionMap:    sync.Map{},
+		Obj:           &corev1.Secret{},
+		Name:          "secret",
+		TriggerSource: schema.KUBERNETES_SECRET,
+		VersionFn:     dataVersion(config.KubernetesSecret.Keys),
 	}
 
 	return h, nil
 }
 
+// dataVersion returns a VersionFn hashing the `.data` and `.stringData` of a Secret, limited to keys if given.
+func dataVersion(keys []string) func(client.Object) (string, error) {
+	return func(obj client.Object) (string, error) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return "", fmt.Errorf("expected a Secret, got %T", obj)
+		}
+		data := map[string][]byte{}
+		for key, value := range secret.Data {
+			data[key] = value
+		}
+		for key, value := range secret.StringData {
+			data[key] = []byte(value)
+		}
+		if len(keys) > 0 {
+			selected := map[string][]byte{}
+			for _, key := range keys {
+				if value, ok := data[key]; ok {
+					selected[key] = value
+				}
+			}
+			data = selected
+		}
+		// encoding/json sorts map keys, so equal data always has the same hash.
+		content, err := json.Marshal(data)
+		if err != nil {
+			return "", err
+		}
+		hash := sha256.Sum256(content)
+		return hex.EncodeToString(hash[:]), nil
+	}
+}
+
 func init() {
 	schema.RegisterProvider(schema.KUBERNETES_SECRET, &Provider{})
 }
@@ -0,0 +1,80 @@
+package k8ssecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDataVersion(t *testing.T) {
+	secret := func(data map[string]string, resourceVersion string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", ResourceVersion: resourceVersion},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+	withAnnotation := secret(map[string]string{"password": "a"}, "2")
+	withAnnotation.Annotations = map[string]string{"reloader": "touched"}
+	withStringData := secret(map[string]string{}, "3")
+	withStringData.StringData = map[string]string{"password": "a"}
+
+	testCases := []struct {
+		name      string
+		keys      []string
+		a, b      *corev1.Secret
+		wantEqual bool
+	}{
+		{
+			name: "data changed",
+			a:    secret(map[string]string{"password": "a"}, "1"),
+			b:    secret(map[string]string{"password": "b"}, "2"),
+		},
+		{
+			name:      "metadata changed",
+			a:         secret(map[string]string{"password": "a"}, "1"),
+			b:         withAnnotation,
+			wantEqual: true,
+		},
+		{
+			name:      "stringData is hashed like data",
+			a:         secret(map[string]string{"password": "a"}, "1"),
+			b:         withStringData,
+			wantEqual: true,
+		},
+		{
+			name:      "unselected key changed",
+			keys:      []string{"password"},
+			a:         secret(map[string]string{"password": "a", "user": "a"}, "1"),
+			b:         secret(map[string]string{"password": "a", "user": "b"}, "2"),
+			wantEqual: true,
+		},
+		{
+			name: "selected key changed",
+			keys: []string{"password", "missing"},
+			a:    secret(map[string]string{"password": "a", "user": "a"}, "1"),
+			b:    secret(map[string]string{"password": "b", "user": "a"}, "2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version := dataVersion(tc.keys)
+			a, err := version(tc.a)
+			require.NoError(t, err)
+			b, err := version(tc.b)
+			require.NoError(t, err)
+			if tc.wantEqual {
+				assert.Equal(t, a, b)
+			} else {
+				assert.NotEqual(t, a, b)
+			}
+		})
+	}
+}
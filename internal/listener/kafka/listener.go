@@ -0,0 +1,120 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const commitTimeout = 10 * time.Second
+
+// Kafka consumes secret change events from Kafka topics as part of a consumer group.
+type Kafka struct {
+	config      *v1alpha1.KafkaConfig
+	context     context.Context
+	cancel      context.CancelFunc
+	client      client.Client
+	eventChan   chan events.SecretRotationEvent
+	logger      logr.Logger
+	kafkaClient *kgo.Client
+	done        chan struct{}
+}
+
+// Start begins consuming the configured topics.
+func (h *Kafka) Start() error {
+	h.logger.Info("Started consuming kafka topics", "topics", h.config.Topics, "group", h.config.ConsumerGroup)
+	h.done = make(chan struct{})
+	go h.consume()
+	return nil
+}
+
+// Stop stops consuming, commits the offsets of the delivered records and leaves the consumer group.
+func (h *Kafka) Stop() error {
+	h.cancel()
+	if h.done != nil {
+		<-h.done
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commitTimeout)
+	defer cancel()
+	err := h.kafkaClient.CommitMarkedOffsets(ctx)
+	h.kafkaClient.Close()
+	return err
+}
+
+func (h *Kafka) consume() {
+	defer close(h.done)
+	for {
+		fetches := h.kafkaClient.PollFetches(h.context)
+		if fetches.IsClientClosed() || h.context.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			h.logger.Error(err, "could not fetch kafka records", "topic", topic, "partition", partition)
+		})
+		iter := fetches.RecordIter()
+		for !iter.Done() {
+			record := iter.Next()
+			if !h.handleRecord(record) {
+				return
+			}
+			h.kafkaClient.MarkCommitRecords(record)
+		}
+	}
+}
+
+// handleRecord publishes the events of a record. It returns false if the listener stopped before all events were delivered.
+func (h *Kafka) handleRecord(record *kgo.Record) bool {
+	h.logger.V(1).Info("Processing record", "topic", record.Topic, "partition", record.Partition, "offset", record.Offset)
+	rotationEvents, err := payload.ExtractEvents(string(record.Value), payload.Paths{
+		Identifier: h.config.SecretIdentifierOnPayload,
+		Namespace:  h.config.NamespacePathOnPayload,
+		Timestamp:  h.config.TimestampPathOnPayload,
+		EventType:  h.config.EventTypePathOnPayload,
+	}, schema.KAFKA)
+	if err != nil {
+		// Records that can never be parsed are skipped, otherwise they would block the partition forever.
+		h.logger.Error(err, "could not extract events from record", "topic", record.Topic, "partition", record.Partition, "offset", record.Offset)
+		return true
+	}
+	for _, event := range rotationEvents {
+		if !h.publish(event) {
+			return false
+		}
+		h.logger.V(1).Info("Published event to eventChan", "Event", event)
+	}
+	return true
+}
+
+// publish sends an event to eventChan, pausing fetches if delivery blocks longer than PauseAfter.
+func (h *Kafka) publish(event events.SecretRotationEvent) bool {
+	if h.config.PauseAfter != nil {
+		timer := time.NewTimer(h.config.PauseAfter.Duration)
+		defer timer.Stop()
+		select {
+		case h.eventChan <- event:
+			return true
+		case <-h.context.Done():
+			return false
+		case <-timer.C:
+		}
+		h.logger.Info("Pausing kafka fetches until pending event is delivered", "topics", h.config.Topics)
+		h.kafkaClient.PauseFetchTopics(h.config.Topics...)
+		defer func() {
+			h.kafkaClient.ResumeFetchTopics(h.config.Topics...)
+			h.logger.Info("Resumed kafka fetches", "topics", h.config.Topics)
+		}()
+	}
+	select {
+	case h.eventChan <- event:
+		return true
+	case <-h.context.Done():
+		return false
+	}
+}
@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testTopic = "secret-rotations"
+
+func TestKafkaListener(t *testing.T) {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, testTopic))
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	producer, err := kgo.NewClient(kgo.SeedBrokers(cluster.ListenAddrs()...))
+	require.NoError(t, err)
+	defer producer.Close()
+	produce := func(values ...string) {
+		for _, value := range values {
+			require.NoError(t, producer.ProduceSync(context.Background(), &kgo.Record{Topic: testTopic, Value: []byte(value)}).FirstErr())
+		}
+	}
+
+	source := &v1alpha1.NotificationSource{
+		Type: "Kafka",
+		Kafka: &v1alpha1.KafkaConfig{
+			Brokers:                   cluster.ListenAddrs(),
+			Topics:                    []string{testTopic},
+			ConsumerGroup:             "reloader",
+			StartOffset:               startOffsetEarliest,
+			SecretIdentifierOnPayload: "after.name",
+			NamespacePathOnPayload:    "after.namespace",
+			PauseAfter:                &metav1.Duration{Duration: 10 * time.Millisecond},
+		},
+	}
+	eventChan := make(chan events.SecretRotationEvent)
+
+	produce(`{"after":{"name":"db","namespace":"default"}}`, `not json`, `{"after":{"name":"api","namespace":"apps"}}`)
+	listener, err := (&Provider{}).CreateListener(context.Background(), source, nil, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+
+	// The first event is received after fetches were paused for the blocked handoff.
+	time.Sleep(100 * time.Millisecond)
+	first := receive(t, eventChan)
+	assert.Equal(t, "db", first.SecretIdentifier)
+	assert.Equal(t, "default", first.Namespace)
+	assert.Equal(t, "Kafka", first.TriggerSource)
+	second := receive(t, eventChan)
+	assert.Equal(t, "api", second.SecretIdentifier)
+	assert.Equal(t, "apps", second.Namespace)
+	require.NoError(t, listener.Stop())
+
+	// Offsets of delivered records were committed, so a new member of the group only sees new records.
+	produce(`{"after":{"name":"cache","namespace":"default"}}`)
+	listener, err = (&Provider{}).CreateListener(context.Background(), source, nil, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	assert.Equal(t, "cache", receive(t, eventChan).SecretIdentifier)
+	require.NoError(t, listener.Stop())
+}
+
+func TestCreateListenerValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source *v1alpha1.NotificationSource
+	}{
+		{name: "nil config", source: &v1alpha1.NotificationSource{Type: "Kafka"}},
+		{name: "missing topics", source: &v1alpha1.NotificationSource{Type: "Kafka", Kafka: &v1alpha1.KafkaConfig{
+			Brokers: []string{"localhost:9092"}, ConsumerGroup: "reloader", SecretIdentifierOnPayload: "name",
+		}}},
+		{name: "missing identifier path", source: &v1alpha1.NotificationSource{Type: "Kafka", Kafka: &v1alpha1.KafkaConfig{
+			Brokers: []string{"localhost:9092"}, Topics: []string{testTopic}, ConsumerGroup: "reloader",
+		}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := (&Provider{}).CreateListener(context.Background(), tc.source, nil, nil, logr.Discard())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func receive(t *testing.T, eventChan chan events.SecretRotationEvent) events.SecretRotationEvent {
+	t.Helper()
+	select {
+	case event := <-eventChan:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return events.SecretRotationEvent{}
+	}
+}
@@ -0,0 +1,120 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	startOffsetEarliest = "earliest"
+
+	mechanismPlain       = "PLAIN"
+	mechanismScramSha256 = "SCRAM-SHA-256"
+	mechanismScramSha512 = "SCRAM-SHA-512"
+)
+
+type Provider struct{}
+
+// CreateListener creates a Kafka consumer group listener.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.Kafka == nil {
+		return nil, errors.New("kafka config is nil")
+	}
+	cfg := config.Kafka
+	if len(cfg.Brokers) == 0 || len(cfg.Topics) == 0 || cfg.ConsumerGroup == "" {
+		return nil, errors.New("kafka brokers, topics and consumerGroup are required")
+	}
+	if cfg.SecretIdentifierOnPayload == "" {
+		return nil, errors.New("kafka identifierPathOnPayload is required")
+	}
+
+	opts, err := clientOptions(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	kafkaClient, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create kafka client: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Kafka{
+		config:      cfg,
+		context:     ctx,
+		cancel:      cancel,
+		client:      client,
+		eventChan:   eventChan,
+		logger:      logger,
+		kafkaClient: kafkaClient,
+	}, nil
+}
+
+func clientOptions(ctx context.Context, c client.Client, cfg *v1alpha1.KafkaConfig) ([]kgo.Opt, error) {
+	resetOffset := kgo.NewOffset().AtEnd()
+	if cfg.StartOffset == startOffsetEarliest {
+		resetOffset = kgo.NewOffset().AtStart()
+	}
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.ConsumeTopics(cfg.Topics...),
+		kgo.ConsumerGroup(cfg.ConsumerGroup),
+		kgo.ConsumeResetOffset(resetOffset),
+		// Only records whose events were handed off are marked, so offsets are never committed ahead of delivery.
+		kgo.AutoCommitMarks(),
+	}
+
+	tlsConfig, err := tlsconfig.Build(ctx, c, cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("could not build kafka tls config: %w", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	if cfg.SASL != nil {
+		mechanism, err := saslMechanism(ctx, c, cfg.SASL)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+	return opts, nil
+}
+
+func saslMechanism(ctx context.Context, c client.Client, cfg *v1alpha1.KafkaSASL) (sasl.Mechanism, error) {
+	username, err := resolvers.SecretKeyRef(ctx, c, &cfg.UsernameRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get kafka sasl username: %w", err)
+	}
+	password, err := resolvers.SecretKeyRef(ctx, c, &cfg.PasswordRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get kafka sasl password: %w", err)
+	}
+	switch cfg.Mechanism {
+	case mechanismPlain:
+		return plain.Auth{User: username, Pass: password}.AsMechanism(), nil
+	case mechanismScramSha256:
+		return scram.Auth{User: username, Pass: password}.AsSha256Mechanism(), nil
+	case mechanismScramSha512, "":
+		return scram.Auth{User: username, Pass: password}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unsupported kafka sasl mechanism %s", cfg.Mechanism)
+	}
+}
+
+func init() {
+	schema.RegisterProvider(schema.KAFKA, &Provider{})
+}
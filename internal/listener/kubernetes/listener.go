@@ -2,66 +2,78 @@ package kubernetes
 
 import (
 	"context"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/pem"
-	"errors"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/external-secrets-inc/reloader/api/v1alpha1"
 	"github.com/external-secrets-inc/reloader/internal/events"
-	"github.com/external-secrets-inc/reloader/internal/listener/schema"
-	"github.com/external-secrets-inc/reloader/pkg/util"
+	"github.com/external-secrets-inc/reloader/internal/util/kubeconfig"
+	"github.com/external-secrets-inc/reloader/internal/util/state"
 	"github.com/go-logr/logr"
-	authenticationv1 "k8s.io/api/authentication/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-// Handler creates a kubernetes Secret watch on a given cluster and sends event
+// Handler creates a kubernetes object watch on a given cluster and sends event
 // on any operation propagated to the watch (create, update, delete).
 type Handler[T client.Object] struct {
-	Config        *v1alpha1.KubernetesObjectConfig
-	Ctx           context.Context
-	Cancel        context.CancelFunc
-	Client        client.Client
-	Mgr           ctrl.Manager
-	CtrlClientSet typedcorev1.CoreV1Interface
-	EventChan     chan events.SecretRotationEvent
-	Logger        logr.Logger
-	VersionMap    sync.Map // map[types.NamespacedName]string
-	Obj           T
-	Name          string
+	Config     *v1alpha1.KubernetesObjectConfig
+	Ctx        context.Context
+	Cancel     context.CancelFunc
+	Client     client.Client
+	Mgr        ctrl.Manager
+	EventChan  chan events.SecretRotationEvent
+	Logger     logr.Logger
+	VersionMap sync.Map // map[types.NamespacedName]string
+	Obj        T
+	Name       string
+	// TriggerSource is the prefix of the TriggerSource of the emitted events.
+	TriggerSource string
+	// VersionFn returns the version of an object used to detect changes.
+	// If nil, the resource version is used.
+	VersionFn func(client.Object) (string, error)
+
+	// dirty is set when the VersionMap changed since the state was last saved.
+	dirty atomic.Bool
 }
 
+// stateSaveInterval is how often changed versions are saved to the state ConfigMap.
+const stateSaveInterval = 10 * time.Second
+
 // Start initiates the Kubernetes Secret listener.
 func (h *Handler[T]) Start() error {
 	log := ctrl.Log.WithName(h.Name + "-watcher")
-	cfg, err := h.getKubeConfig()
+	cfg, err := kubeconfig.RESTConfig(h.Ctx, h.Client, h.Config.ServerURL, h.Config.Auth)
 	if err != nil {
 		return fmt.Errorf("could not parse config: %w", err)
 	}
-	h.CtrlClientSet, err = typedcorev1.NewForConfig(cfg)
-	if err != nil {
-		return fmt.Errorf("could not create client set: %w", err)
-	}
 	metricsServerOptions := metricsserver.Options{
 		BindAddress: "0",
 	}
+	cacheOptions := cache.Options{}
+	if h.Config.Namespace != "" {
+		cacheOptions.DefaultNamespaces = map[string]cache.Config{h.Config.Namespace: {}}
+	}
 	manager, err := ctrl.NewManager(cfg, ctrl.Options{
 		Metrics: metricsServerOptions,
+		Cache:   cacheOptions,
 	})
 	if err != nil {
 		return fmt.Errorf("could not create manager: %w", err)
@@ -77,37 +89,20 @@ func (h *Handler[T]) Start() error {
 	err = ctrl.
 		NewControllerManagedBy(manager).
 		Named("k8s"+h.Name).
-		Watches(h.Obj, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, s client.Object) []ctrl.Request {
-			secret, ok := s.(T)
-			if !ok {
-				log.Error(err, "while processing secret")
-				return nil
-			}
-			if secret.GetDeletionTimestamp() != nil {
-				log.V(2).Info("skipping deleted secret", "namespace", secret.GetNamespace(), "name", secret.GetName())
-				return nil
-			}
-			version := secret.GetResourceVersion()
-			storedVersion, loaded := h.VersionMap.LoadOrStore(types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()}, version)
-			if !loaded {
-				log.V(2).Info(h.Name+" not added to cache, skipping", "namespace", secret.GetNamespace(), "name", secret.GetName())
-				return nil
-			}
-			if version == storedVersion {
-				// Happens for some weird reason
-				log.V(2).Info("skipping object with same version", "namespace", secret.GetNamespace(), "name", secret.GetName())
-				return nil
-			}
-			// Safe to send event
-			h.VersionMap.Store(types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()}, version)
-			h.EventChan <- events.SecretRotationEvent{
-				SecretIdentifier:  secret.GetName(),
-				Namespace:         secret.GetNamespace(),
-				RotationTimestamp: time.Now().Format(time.RFC3339),
-				TriggerSource:     fmt.Sprintf("%s/%s", schema.KUBERNETES_SECRET, secret.GetName()),
-			}
-			return nil
-		}), opts...).
+		Watches(h.Obj, handler.Funcs{
+			CreateFunc: func(ctx context.Context, e event.CreateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.handle(ctx, log, e.Object)
+			},
+			UpdateFunc: func(ctx context.Context, e event.UpdateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.handle(ctx, log, e.ObjectNew)
+			},
+			DeleteFunc: func(ctx context.Context, e event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.forget(log, e.Object)
+			},
+			GenericFunc: func(ctx context.Context, e event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.handle(ctx, log, e.Object)
+			},
+		}, opts...).
 		Complete(reconcile.Func(func(ctx context.Context, r reconcile.Request) (reconcile.Result, error) {
 			// We dont need to reconcile anything, as we are sending this over another controller
 			return reconcile.Result{}, nil
@@ -116,6 +111,12 @@ func (h *Handler[T]) Start() error {
 		return fmt.Errorf("could not create controller: %w", err)
 	}
 	h.Mgr = manager
+	if h.Config.StateConfigMapRef != nil {
+		if err := h.loadState(h.Ctx); err != nil {
+			return err
+		}
+		go h.persistState()
+	}
 	go func() {
 		if err := manager.Start(h.Ctx); err != nil {
 			h.Logger.Error(err, "failed to start watching "+h.Name)
@@ -124,143 +125,145 @@ func (h *Handler[T]) Start() error {
 	return nil
 }
 
-// Stop stops the Watch by closing the stop channel.
-func (h *Handler[T]) Stop() error {
-	h.Cancel()
-	return nil
+func (h *Handler[T]) version(obj client.Object) (string, error) {
+	if h.VersionFn == nil {
+		return obj.GetResourceVersion(), nil
+	}
+	return h.VersionFn(obj)
 }
 
-func (h *Handler[T]) getKubeConfig() (*rest.Config, error) {
-	if h.Config.Auth == nil {
-		h.Logger.V(1).Info("no auth specified - using default config")
-		cfg, err := ctrl.GetConfig()
+// FieldVersion returns a VersionFn hashing the value at the given field path, e.g. `.data` or `.status.certificate`.
+// A missing field has the same version as a null one.
+func FieldVersion(fieldPath string) func(client.Object) (string, error) {
+	fields := strings.Split(strings.TrimPrefix(fieldPath, "."), ".")
+	return func(obj client.Object) (string, error) {
+		content, ok := obj.(runtime.Unstructured)
+		var object map[string]any
+		if ok {
+			object = content.UnstructuredContent()
+		} else {
+			var err error
+			if object, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+				return "", err
+			}
+		}
+		value, _, err := unstructured.NestedFieldNoCopy(object, fields...)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get default config: %w", err)
+			return "", err
 		}
-		return cfg, nil
-	}
-	if h.Config.Auth.KubeConfigRef != nil {
-		cfg, err := h.fetchSecretKey(h.Ctx, &h.Config.Auth.KubeConfigRef.SecretRef)
+		data, err := json.Marshal(value)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-
-		return clientcmd.RESTConfigFromKubeConfig(cfg)
+		hash := sha256.Sum256(data)
+		return hex.EncodeToString(hash[:]), nil
 	}
+}
 
-	if h.Config.ServerURL == "" {
-		return nil, errors.New("no server URL provided")
+func (h *Handler[T]) handle(ctx context.Context, log logr.Logger, s client.Object) {
+	obj, ok := s.(T)
+	if !ok {
+		log.Error(fmt.Errorf("unexpected type %T", s), "while processing "+h.Name)
+		return
 	}
+	h.observe(ctx, log, obj)
+}
 
-	cfg := &rest.Config{
-		Host: h.Config.ServerURL,
+// forget removes a deleted object from the VersionMap, so the state does not grow with deleted objects.
+func (h *Handler[T]) forget(log logr.Logger, obj client.Object) {
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	if _, loaded := h.VersionMap.LoadAndDelete(key); loaded {
+		log.V(2).Info("removed deleted "+h.Name+" from cache", "namespace", obj.GetNamespace(), "name", obj.GetName())
+		h.dirty.Store(true)
 	}
+}
 
-	ca, err := h.fetchCACertFromSource(certOpts{
-		CABundle: []byte(h.Config.Auth.CABundle),
-	})
+// observe emits an event if the version of obj changed since it was last seen.
+// Objects seen for the first time are only recorded.
+func (h *Handler[T]) observe(ctx context.Context, log logr.Logger, obj T) {
+	if obj.GetDeletionTimestamp() != nil {
+		log.V(2).Info("skipping deleted "+h.Name, "namespace", obj.GetNamespace(), "name", obj.GetName())
+		return
+	}
+	version, err := h.version(obj)
 	if err != nil {
-		return nil, err
+		log.Error(err, "could not get version", "namespace", obj.GetNamespace(), "name", obj.GetName())
+		return
 	}
-
-	cfg.TLSClientConfig = rest.TLSClientConfig{
-		Insecure: false,
-		CAData:   ca,
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	storedVersion, loaded := h.VersionMap.LoadOrStore(key, version)
+	if !loaded {
+		log.V(2).Info(h.Name+" not added to cache, skipping", "namespace", obj.GetNamespace(), "name", obj.GetName())
+		h.dirty.Store(true)
+		return
 	}
-
-	switch {
-	case h.Config.Auth.TokenRef != nil:
-		token, err := h.fetchSecretKey(h.Ctx, &h.Config.Auth.TokenRef.SecretRef)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch Auth.Token.BearerToken: %w", err)
-		}
-		cfg.BearerToken = string(token)
-	case h.Config.Auth.ServiceAccountRef != nil:
-		token, err := h.serviceAccountToken(h.Ctx, h.Config.Auth.ServiceAccountRef)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch Auth.ServiceAccount: %w", err)
-		}
-		cfg.BearerToken = string(token)
-	default:
-		return nil, errors.New("no auth provider given")
+	if version == storedVersion {
+		log.V(2).Info("skipping object with same version", "namespace", obj.GetNamespace(), "name", obj.GetName())
+		return
 	}
-
-	return cfg, nil
-
-}
-
-func (h *Handler[T]) fetchSecretKey(ctx context.Context, secretKeySelector *v1alpha1.SecretKeySelector) ([]byte, error) {
-	if secretKeySelector == nil {
-		return nil, errors.New("secret key selector is nil")
+	event := events.SecretRotationEvent{
+		SecretIdentifier:  obj.GetName(),
+		Namespace:         obj.GetNamespace(),
+		RotationTimestamp: time.Now().Format(time.RFC3339),
+		TriggerSource:     fmt.Sprintf("%s/%s", h.TriggerSource, obj.GetName()),
 	}
-	secret, err := util.GetSecret(ctx, h.Client, secretKeySelector.Name, secretKeySelector.Namespace, h.Logger)
-	if err != nil {
-		return nil, fmt.Errorf("could not fetch secret key: %w", err)
+	select {
+	case h.EventChan <- event:
+	case <-ctx.Done():
+		return
 	}
-	data, ok := secret.Data[secretKeySelector.Key]
-	if !ok {
-		return nil, fmt.Errorf("key %s not found in secret %s", secretKeySelector.Key, secretKeySelector.Name)
-	}
-	return data, nil
+	// The version is only recorded once the event was delivered, so a persisted state never skips an undelivered event.
+	h.VersionMap.Store(key, version)
+	h.dirty.Store(true)
 }
 
-func (h *Handler[T]) serviceAccountToken(ctx context.Context, serviceAccountSelector *v1alpha1.ServiceAccountSelector) ([]byte, error) {
-	if serviceAccountSelector == nil {
-		return nil, errors.New("service account selector is nil")
-	}
-	namespace := serviceAccountSelector.Namespace
-	expirationSeconds := int64(3600)
-	tr, err := h.CtrlClientSet.ServiceAccounts(namespace).CreateToken(ctx, serviceAccountSelector.Name, &authenticationv1.TokenRequest{
-		Spec: authenticationv1.TokenRequestSpec{
-			Audiences:         serviceAccountSelector.Audiences,
-			ExpirationSeconds: &expirationSeconds,
-		},
-	}, metav1.CreateOptions{})
+// loadState fills the VersionMap with the versions persisted in the state ConfigMap.
+func (h *Handler[T]) loadState(ctx context.Context) error {
+	known, _, err := state.Load(ctx, h.Client, h.Config.StateConfigMapRef)
 	if err != nil {
-		return nil, fmt.Errorf("could not create token: %w", err)
+		return err
+	}
+	for key, version := range known {
+		namespace, name, _ := strings.Cut(key, "/")
+		h.VersionMap.Store(types.NamespacedName{Namespace: namespace, Name: name}, version)
 	}
-	return []byte(tr.Status.Token), nil
+	return nil
 }
 
-func (h *Handler[T]) fetchCACertFromSource(certOpts certOpts) ([]byte, error) {
-	if len(certOpts.CABundle) > 0 {
-		pem, err := base64decode(certOpts.CABundle)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode ca bundle: %w", err)
+// persistState periodically saves the VersionMap to the state ConfigMap until the handler stops.
+func (h *Handler[T]) persistState() {
+	ticker := time.NewTicker(stateSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.saveState(h.Ctx)
+		case <-h.Ctx.Done():
+			ctx, cancel := context.WithTimeout(context.Background(), stateSaveInterval)
+			defer cancel()
+			h.saveState(ctx)
+			return
 		}
-
-		return pem, nil
 	}
-	return nil, nil
 }
 
-type certOpts struct {
-	CABundle []byte
-}
-
-func base64decode(cert []byte) ([]byte, error) {
-	if c, err := parseCertificateBytes(cert); err == nil {
-		return c, nil
+func (h *Handler[T]) saveState(ctx context.Context) {
+	if !h.dirty.Swap(false) {
+		return
 	}
-
-	// try b64 decoding and test for validity again...
-	certificate, err := base64.StdEncoding.DecodeString(string(cert))
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	known := map[string]string{}
+	h.VersionMap.Range(func(key, version any) bool {
+		known[key.(types.NamespacedName).String()] = version.(string)
+		return true
+	})
+	if err := state.Save(ctx, h.Client, h.Config.StateConfigMapRef, known); err != nil {
+		h.dirty.Store(true)
+		h.Logger.Error(err, "failed to save "+h.Name+" versions")
 	}
-
-	return parseCertificateBytes(certificate)
 }
 
-func parseCertificateBytes(certBytes []byte) ([]byte, error) {
-	block, _ := pem.Decode(certBytes)
-	if block == nil {
-		return nil, errors.New("failed to parse the new certificate, not valid pem data")
-	}
-
-	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
-		return nil, fmt.Errorf("failed to validate certificate: %w", err)
-	}
-
-	return certBytes, nil
+// Stop stops the Watch by closing the stop channel.
+func (h *Handler[T]) Stop() error {
+	h.Cancel()
+	return nil
 }
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/util/state"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFieldVersion(t *testing.T) {
+	certificate := func(cert string, revision int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata":   map[string]any{"name": "tls", "resourceVersion": "1"},
+			"status":     map[string]any{"certificate": cert, "revision": revision},
+		}}
+	}
+	secret := func(value string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Labels: labels},
+			Data:       map[string][]byte{"password": []byte(value)},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		fieldPath string
+		a, b      client.Object
+		wantEqual bool
+	}{
+		{
+			name:      "unstructured field changed",
+			fieldPath: ".status.certificate",
+			a:         certificate("a", 1),
+			b:         certificate("b", 2),
+		},
+		{
+			name:      "unstructured unrelated field changed",
+			fieldPath: ".status.certificate",
+			a:         certificate("a", 1),
+			b:         certificate("a", 2),
+			wantEqual: true,
+		},
+		{
+			name:      "missing field",
+			fieldPath: ".status.missing",
+			a:         certificate("a", 1),
+			b:         certificate("b", 2),
+			wantEqual: true,
+		},
+		{
+			name:      "typed data changed",
+			fieldPath: ".data",
+			a:         secret("a", nil),
+			b:         secret("b", nil),
+		},
+		{
+			name:      "typed metadata changed",
+			fieldPath: "data",
+			a:         secret("a", nil),
+			b:         secret("a", map[string]string{"team": "payments"}),
+			wantEqual: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version := FieldVersion(tc.fieldPath)
+			a, err := version(tc.a)
+			require.NoError(t, err)
+			b, err := version(tc.b)
+			require.NoError(t, err)
+			assert.NotEmpty(t, a)
+			if tc.wantEqual {
+				assert.Equal(t, a, b)
+			} else {
+				assert.NotEqual(t, a, b)
+			}
+		})
+	}
+}
+
+func TestObserveWithState(t *testing.T) {
+	ref := &v1alpha1.ConfigMapKeySelector{Name: "reloader-state", Namespace: "reloader", Key: "secrets"}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	ctx := context.Background()
+	require.NoError(t, state.Save(ctx, c, ref, map[string]string{"default/db": "1"}))
+
+	secret := func(name, resourceVersion string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion}}
+	}
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	h := &Handler[*corev1.Secret]{
+		Config:        &v1alpha1.KubernetesObjectConfig{StateConfigMapRef: ref},
+		Ctx:           ctx,
+		Client:        c,
+		EventChan:     eventChan,
+		Logger:        logr.Discard(),
+		Name:          "secret",
+		TriggerSource: "KubernetesSecret",
+	}
+	require.NoError(t, h.loadState(ctx))
+
+	// The secret changed while the reloader was down: the first observation fires.
+	h.observe(ctx, logr.Discard(), secret("db", "2"))
+	require.Len(t, eventChan, 1)
+	event := <-eventChan
+	assert.Equal(t, "db", event.SecretIdentifier)
+	assert.Equal(t, "default", event.Namespace)
+	assert.Equal(t, "KubernetesSecret/db", event.TriggerSource)
+
+	h.observe(ctx, logr.Discard(), secret("db", "2"))
+	h.observe(ctx, logr.Discard(), secret("api", "1"))
+	assert.Empty(t, eventChan)
+
+	h.saveState(ctx)
+	known, found, err := state.Load(ctx, c, ref)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string]string{"default/db": "2", "default/api": "1"}, known)
+
+	// Deleted secrets are removed from the state.
+	h.forget(logr.Discard(), secret("api", "1"))
+	h.saveState(ctx)
+	known, _, err = state.Load(ctx, c, ref)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"default/db": "2"}, known)
+}
@@ -141,6 +141,30 @@ func generateListenerKey(source esov1alpha1.NotificationSource) (string, error)
 		config = source.Mock
 	case schema.KUBERNETES_SECRET:
 		config = source.KubernetesSecret
+	case schema.KUBERNETES_CONFIG_MAP:
+		config = source.KubernetesConfigMap
+	case schema.KUBERNETES_OBJECT:
+		config = source.KubernetesObject
+	case schema.CLOUD_EVENTS:
+		config = source.CloudEvents
+	case schema.KAFKA:
+		config = source.Kafka
+	case schema.NATS:
+		config = source.Nats
+	case schema.REDIS:
+		config = source.Redis
+	case schema.AMQP:
+		config = source.Amqp
+	case schema.MQTT:
+		config = source.Mqtt
+	case schema.AWS_SECRETS_MANAGER_POLL:
+		config = source.AwsSecretsManagerPoll
+	case schema.GOOGLE_SECRET_MANAGER_POLL:
+		config = source.GoogleSecretManagerPoll
+	case schema.HASHICORP_VAULT_POLL:
+		config = source.HashicorpVaultPoll
+	case schema.AZURE_KEY_VAULT_POLL:
+		config = source.AzureKeyVaultPoll
 	default:
 		return "", fmt.Errorf("unsupported notification source type: %s", source.Type)
 	}
@@ -0,0 +1,62 @@
+package listener
+
+import (
+	"testing"
+
+	esov1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourcesAreRegistered(t *testing.T) {
+	sourceTypes := []string{
+		schema.AWS_SQS,
+		schema.AZURE_EVENT_GRID,
+		schema.GOOGLE_PUB_SUB,
+		schema.WEBHOOK,
+		schema.TCP_SOCKET,
+		schema.HASHICORP_VAULT,
+		schema.MOCK,
+		schema.KUBERNETES_SECRET,
+		schema.KUBERNETES_CONFIG_MAP,
+		schema.KUBERNETES_OBJECT,
+		schema.CLOUD_EVENTS,
+		schema.KAFKA,
+		schema.NATS,
+		schema.REDIS,
+		schema.AMQP,
+		schema.MQTT,
+		schema.AWS_SECRETS_MANAGER_POLL,
+		schema.GOOGLE_SECRET_MANAGER_POLL,
+		schema.HASHICORP_VAULT_POLL,
+		schema.AZURE_KEY_VAULT_POLL,
+	}
+	for _, sourceType := range sourceTypes {
+		t.Run(sourceType, func(t *testing.T) {
+			assert.NotNil(t, schema.GetProvider(sourceType))
+			_, err := generateListenerKey(esov1alpha1.NotificationSource{Type: sourceType})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateListenerKey(t *testing.T) {
+	source := func(serverURL string) esov1alpha1.NotificationSource {
+		return esov1alpha1.NotificationSource{
+			Type:                schema.KUBERNETES_CONFIG_MAP,
+			KubernetesConfigMap: &esov1alpha1.KubernetesConfigMapConfig{ServerURL: serverURL},
+		}
+	}
+	a, err := generateListenerKey(source("https://a.example.com"))
+	require.NoError(t, err)
+	again, err := generateListenerKey(source("https://a.example.com"))
+	require.NoError(t, err)
+	b, err := generateListenerKey(source("https://b.example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, a, again)
+	assert.NotEqual(t, a, b)
+
+	_, err = generateListenerKey(esov1alpha1.NotificationSource{Type: "Unknown"})
+	assert.Error(t, err)
+}
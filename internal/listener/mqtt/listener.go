@@ -0,0 +1,144 @@
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	"github.com/tidwall/gjson"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const disconnectQuiesce = 250
+
+// Mqtt consumes secret change events from MQTT topic filters.
+type Mqtt struct {
+	config     *v1alpha1.MqttConfig
+	context    context.Context
+	cancel     context.CancelFunc
+	client     client.Client
+	eventChan  chan events.SecretRotationEvent
+	logger     logr.Logger
+	mqttClient paho.Client
+}
+
+// Start connects to the broker. Connection attempts are retried with backoff in the background,
+// and subscriptions are renewed on every connect.
+func (h *Mqtt) Start() error {
+	h.logger.Info("Connecting to mqtt brokers", "brokers", h.config.Brokers, "clientID", h.config.ClientID)
+	h.mqttClient.Connect()
+	return nil
+}
+
+// Stop disconnects from the broker.
+func (h *Mqtt) Stop() error {
+	h.cancel()
+	h.mqttClient.Disconnect(disconnectQuiesce)
+	return nil
+}
+
+func (h *Mqtt) onConnect(c paho.Client) {
+	filters := make(map[string]byte, len(h.config.Topics))
+	for _, topic := range h.config.Topics {
+		filters[topic.Filter] = byte(topic.QoS)
+	}
+	token := c.SubscribeMultiple(filters, h.handleMessage)
+	go func() {
+		<-token.Done()
+		if err := token.Error(); err != nil {
+			h.logger.Error(err, "could not subscribe to mqtt topics", "topics", h.config.Topics)
+			return
+		}
+		h.logger.Info("Subscribed to mqtt topics", "topics", h.config.Topics)
+	}()
+}
+
+// handleMessage acknowledges a message once its events were delivered. Messages interrupted by Stop are not
+// acknowledged, so the broker redelivers them when the persistent session is resumed.
+func (h *Mqtt) handleMessage(_ paho.Client, msg paho.Message) {
+	rotationEvents, err := h.extractEvents(msg.Topic(), string(msg.Payload()))
+	if err != nil {
+		h.logger.Error(err, "could not extract events from message", "topic", msg.Topic())
+		msg.Ack()
+		return
+	}
+	for _, event := range rotationEvents {
+		select {
+		case h.eventChan <- event:
+			h.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-h.context.Done():
+			return
+		}
+	}
+	msg.Ack()
+}
+
+func (h *Mqtt) extractEvents(topic, data string) ([]events.SecretRotationEvent, error) {
+	h.logger.V(1).Info("Processing Message", "Topic", topic, "Message", data)
+	if h.config.IdentifierTopicSegment == nil {
+		rotationEvents, err := payload.ExtractEvents(data, payload.Paths{
+			Identifier: h.config.SecretIdentifierOnPayload,
+			Namespace:  h.config.NamespacePathOnPayload,
+			Timestamp:  h.config.TimestampPathOnPayload,
+			EventType:  h.config.EventTypePathOnPayload,
+		}, schema.MQTT)
+		if err != nil {
+			return nil, err
+		}
+		if h.config.NamespaceTopicSegment != nil {
+			namespace, err := topicSegment(topic, *h.config.NamespaceTopicSegment)
+			if err != nil {
+				return nil, err
+			}
+			for i := range rotationEvents {
+				rotationEvents[i].Namespace = namespace
+			}
+		}
+		return rotationEvents, nil
+	}
+
+	identifier, err := topicSegment(topic, *h.config.IdentifierTopicSegment)
+	if err != nil {
+		return nil, err
+	}
+	event := events.SecretRotationEvent{
+		SecretIdentifier:  identifier,
+		RotationTimestamp: time.Now().Format(payload.TimestampFormat),
+		TriggerSource:     schema.MQTT,
+	}
+	// The payload is optional in this mode: the other paths only apply if it is valid json.
+	validPayload := gjson.Valid(data)
+	if h.config.NamespaceTopicSegment != nil {
+		if event.Namespace, err = topicSegment(topic, *h.config.NamespaceTopicSegment); err != nil {
+			return nil, err
+		}
+	} else if h.config.NamespacePathOnPayload != "" && validPayload {
+		event.Namespace = gjson.Get(data, h.config.NamespacePathOnPayload).String()
+	}
+	if h.config.TimestampPathOnPayload != "" && validPayload {
+		if timestamp := gjson.Get(data, h.config.TimestampPathOnPayload).String(); timestamp != "" {
+			event.RotationTimestamp = timestamp
+		}
+	}
+	if h.config.EventTypePathOnPayload != "" && validPayload {
+		if eventType := gjson.Get(data, h.config.EventTypePathOnPayload).String(); eventType != "" {
+			event.TriggerSource = fmt.Sprintf("%s/%s", schema.MQTT, eventType)
+		}
+	}
+	return []events.SecretRotationEvent{event}, nil
+}
+
+func topicSegment(topic string, index int32) (string, error) {
+	segments := strings.Split(topic, "/")
+	if index < 0 || int(index) >= len(segments) || segments[index] == "" {
+		return "", fmt.Errorf("topic %s has no segment %d", topic, index)
+	}
+	return segments[index], nil
+}
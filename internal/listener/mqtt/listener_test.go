@@ -0,0 +1,146 @@
+package mqtt
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runBroker(t *testing.T) (*mochi.Server, string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	server := mochi.New(&mochi.Options{InlineClient: true})
+	require.NoError(t, server.AddHook(new(auth.AllowHook), nil))
+	require.NoError(t, server.AddListener(listeners.NewTCP(listeners.Config{ID: "tcp", Address: addr})))
+	go func() { _ = server.Serve() }()
+	t.Cleanup(func() { _ = server.Close() })
+	return server, "tcp://" + addr
+}
+
+func TestMqttListener(t *testing.T) {
+	server, broker := runBroker(t)
+	segment := int32(2)
+	namespace := int32(1)
+	eventChan := make(chan events.SecretRotationEvent)
+	listener, err := (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{
+		Type: "Mqtt",
+		Mqtt: &v1alpha1.MqttConfig{
+			Brokers:                []string{broker},
+			ClientID:               "reloader",
+			Topics:                 []v1alpha1.MqttTopic{{Filter: "secrets/+/+", QoS: 1}},
+			IdentifierTopicSegment: &segment,
+			NamespaceTopicSegment:  &namespace,
+		},
+	}, nil, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	defer func() { assert.NoError(t, listener.Stop()) }()
+
+	// Subscriptions are made asynchronously once connected, so publish until the first message arrives.
+	var event events.SecretRotationEvent
+	require.Eventually(t, func() bool {
+		require.NoError(t, server.Publish("secrets/factory-1/db-password", []byte(`{}`), false, 1))
+		select {
+		case event = <-eventChan:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "db-password", event.SecretIdentifier)
+	assert.Equal(t, "factory-1", event.Namespace)
+	assert.Equal(t, "Mqtt", event.TriggerSource)
+}
+
+func TestExtractEvents(t *testing.T) {
+	segment := int32(1)
+	testCases := []struct {
+		name            string
+		config          *v1alpha1.MqttConfig
+		topic           string
+		payload         string
+		wantIdentifiers []string
+		wantNamespace   string
+		wantTimestamp   string
+		wantSource      string
+		wantErr         bool
+	}{
+		{
+			name:            "identifier from payload",
+			config:          &v1alpha1.MqttConfig{SecretIdentifierOnPayload: "secrets", NamespacePathOnPayload: "namespace"},
+			topic:           "rotations",
+			payload:         `{"secrets":["db","api"],"namespace":"apps"}`,
+			wantIdentifiers: []string{"db", "api"},
+			wantNamespace:   "apps",
+		},
+		{
+			name:            "identifier from topic and namespace from payload",
+			config:          &v1alpha1.MqttConfig{IdentifierTopicSegment: &segment, NamespacePathOnPayload: "namespace"},
+			topic:           "rotations/db",
+			payload:         `{"namespace":"apps"}`,
+			wantIdentifiers: []string{"db"},
+			wantNamespace:   "apps",
+		},
+		{
+			name: "identifier from topic with timestamp and event type from payload",
+			config: &v1alpha1.MqttConfig{
+				IdentifierTopicSegment: &segment, TimestampPathOnPayload: "rotatedAt", EventTypePathOnPayload: "type",
+			},
+			topic:           "rotations/db",
+			payload:         `{"rotatedAt":"2024-01-01T00:00:00Z","type":"rotated"}`,
+			wantIdentifiers: []string{"db"},
+			wantTimestamp:   "2024-01-01T00:00:00Z",
+			wantSource:      "Mqtt/rotated",
+		},
+		{
+			name:            "identifier from topic with non json payload",
+			config:          &v1alpha1.MqttConfig{IdentifierTopicSegment: &segment},
+			topic:           "rotations/db",
+			payload:         `rotated`,
+			wantIdentifiers: []string{"db"},
+		},
+		{
+			name:    "missing topic segment",
+			config:  &v1alpha1.MqttConfig{IdentifierTopicSegment: &segment},
+			topic:   "rotations",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &Mqtt{config: tc.config, logger: logr.Discard()}
+			got, err := h.extractEvents(tc.topic, tc.payload)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, len(tc.wantIdentifiers))
+			for i, event := range got {
+				assert.Equal(t, tc.wantIdentifiers[i], event.SecretIdentifier)
+				assert.Equal(t, tc.wantNamespace, event.Namespace)
+				if tc.wantTimestamp != "" {
+					assert.Equal(t, tc.wantTimestamp, event.RotationTimestamp)
+				}
+				if tc.wantSource != "" {
+					assert.Equal(t, tc.wantSource, event.TriggerSource)
+				}
+			}
+		})
+	}
+}
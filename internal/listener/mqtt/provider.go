@@ -0,0 +1,106 @@
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultMaxReconnectInterval = 2 * time.Minute
+	connectRetryInterval        = 5 * time.Second
+)
+
+type Provider struct{}
+
+// CreateListener creates an MQTT subscriber.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.Mqtt == nil {
+		return nil, errors.New("mqtt config is nil")
+	}
+	cfg := config.Mqtt
+	if len(cfg.Brokers) == 0 || len(cfg.Topics) == 0 || cfg.ClientID == "" {
+		return nil, errors.New("mqtt brokers, topics and clientID are required")
+	}
+	if cfg.IdentifierTopicSegment == nil && cfg.SecretIdentifierOnPayload == "" {
+		return nil, errors.New("one of mqtt identifierTopicSegment or identifierPathOnPayload is required")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	h := &Mqtt{
+		config:    cfg,
+		context:   ctx,
+		cancel:    cancel,
+		client:    client,
+		eventChan: eventChan,
+		logger:    logger,
+	}
+	opts, err := h.clientOptions(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	h.mqttClient = paho.NewClient(opts)
+	return h, nil
+}
+
+func (h *Mqtt) clientOptions(ctx context.Context) (*paho.ClientOptions, error) {
+	maxReconnectInterval := defaultMaxReconnectInterval
+	if h.config.MaxReconnectInterval != nil {
+		maxReconnectInterval = h.config.MaxReconnectInterval.Duration
+	}
+	opts := paho.NewClientOptions().
+		SetClientID(h.config.ClientID).
+		SetCleanSession(h.config.CleanSession).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(connectRetryInterval).
+		SetMaxReconnectInterval(maxReconnectInterval).
+		// Messages are acknowledged once their events were handed off to the reloader.
+		SetAutoAckDisabled(true).
+		SetOrderMatters(false).
+		SetOnConnectHandler(h.onConnect).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			h.logger.Error(err, "Lost mqtt connection, reconnecting")
+		})
+	for _, broker := range h.config.Brokers {
+		opts.AddBroker(broker)
+	}
+
+	tlsConfig, err := tlsconfig.Build(ctx, h.client, h.config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("could not build mqtt tls config: %w", err)
+	}
+	if tlsConfig != nil {
+		opts.SetTLSConfig(tlsConfig)
+	}
+	if h.config.UsernameRef != nil {
+		username, err := resolvers.SecretKeyRef(ctx, h.client, h.config.UsernameRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get mqtt username: %w", err)
+		}
+		opts.SetUsername(username)
+	}
+	if h.config.PasswordRef != nil {
+		password, err := resolvers.SecretKeyRef(ctx, h.client, h.config.PasswordRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get mqtt password: %w", err)
+		}
+		opts.SetPassword(password)
+	}
+	return opts, nil
+}
+
+func init() {
+	schema.RegisterProvider(schema.MQTT, &Provider{})
+}
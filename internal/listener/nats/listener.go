@@ -0,0 +1,164 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Nats consumes secret change events from a NATS subject or a JetStream durable consumer.
+type Nats struct {
+	config       *v1alpha1.NatsConfig
+	context      context.Context
+	cancel       context.CancelFunc
+	client       client.Client
+	eventChan    chan events.SecretRotationEvent
+	logger       logr.Logger
+	options      []natsgo.Option
+	conn         *natsgo.Conn
+	subscription *natsgo.Subscription
+	consumer     jetstream.ConsumeContext
+}
+
+// Start connects to NATS and starts consuming messages.
+func (h *Nats) Start() error {
+	conn, err := natsgo.Connect(strings.Join(h.config.Servers, ","), h.options...)
+	if err != nil {
+		return fmt.Errorf("could not connect to nats: %w", err)
+	}
+	h.conn = conn
+
+	if h.config.JetStream == nil {
+		h.subscription, err = conn.QueueSubscribe(h.config.Subject, h.config.QueueGroup, func(msg *natsgo.Msg) {
+			h.handleMessage(msg.Data)
+		})
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("could not subscribe to nats subject %s: %w", h.config.Subject, err)
+		}
+		h.logger.Info("Started subscribing to nats subject", "subject", h.config.Subject)
+		return nil
+	}
+
+	if err := h.consumeJetStream(); err != nil {
+		conn.Close()
+		return err
+	}
+	h.logger.Info("Started consuming nats jetstream", "stream", h.config.JetStream.Stream, "durable", h.config.JetStream.Durable)
+	return nil
+}
+
+// Stop stops consuming and drains the NATS connection.
+func (h *Nats) Stop() error {
+	h.cancel()
+	if h.consumer != nil {
+		h.consumer.Stop()
+	}
+	if h.conn == nil {
+		return nil
+	}
+	return h.conn.Drain()
+}
+
+func (h *Nats) consumeJetStream() error {
+	js, err := jetstream.New(h.conn)
+	if err != nil {
+		return fmt.Errorf("could not create jetstream context: %w", err)
+	}
+	cfg := h.config.JetStream
+	consumerConfig := jetstream.ConsumerConfig{
+		Durable:       cfg.Durable,
+		FilterSubject: h.config.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		DeliverPolicy: deliverPolicy(cfg.DeliverPolicy),
+	}
+	if cfg.AckWait != nil {
+		consumerConfig.AckWait = cfg.AckWait.Duration
+	}
+	if cfg.MaxDeliver != nil {
+		consumerConfig.MaxDeliver = int(*cfg.MaxDeliver)
+	}
+	consumer, err := js.CreateOrUpdateConsumer(h.context, cfg.Stream, consumerConfig)
+	if err != nil {
+		return fmt.Errorf("could not create jetstream consumer %s: %w", cfg.Durable, err)
+	}
+	h.consumer, err = consumer.Consume(h.handleJetStreamMessage)
+	if err != nil {
+		return fmt.Errorf("could not consume jetstream consumer %s: %w", cfg.Durable, err)
+	}
+	return nil
+}
+
+func deliverPolicy(policy string) jetstream.DeliverPolicy {
+	switch policy {
+	case "all":
+		return jetstream.DeliverAllPolicy
+	case "last":
+		return jetstream.DeliverLastPolicy
+	default:
+		return jetstream.DeliverNewPolicy
+	}
+}
+
+// handleJetStreamMessage acknowledges a message only after its events were delivered.
+func (h *Nats) handleJetStreamMessage(msg jetstream.Msg) {
+	rotationEvents, err := h.extractEvents(msg.Data())
+	if err != nil {
+		h.logger.Error(err, "could not extract events from message", "subject", msg.Subject())
+		// The message will never be parsable, so it must not be redelivered.
+		if err := msg.Term(); err != nil {
+			h.logger.Error(err, "could not terminate message", "subject", msg.Subject())
+		}
+		return
+	}
+	if !h.publish(rotationEvents) {
+		if err := msg.Nak(); err != nil {
+			h.logger.Error(err, "could not nak message", "subject", msg.Subject())
+		}
+		return
+	}
+	if err := msg.Ack(); err != nil {
+		h.logger.Error(err, "could not ack message", "subject", msg.Subject())
+	}
+}
+
+func (h *Nats) handleMessage(data []byte) {
+	rotationEvents, err := h.extractEvents(data)
+	if err != nil {
+		h.logger.Error(err, "could not extract events from message", "subject", h.config.Subject)
+		return
+	}
+	h.publish(rotationEvents)
+}
+
+func (h *Nats) extractEvents(data []byte) ([]events.SecretRotationEvent, error) {
+	h.logger.V(1).Info("Processing Message", "Message", string(data))
+	return payload.ExtractEvents(string(data), payload.Paths{
+		Identifier: h.config.SecretIdentifierOnPayload,
+		Namespace:  h.config.NamespacePathOnPayload,
+		Timestamp:  h.config.TimestampPathOnPayload,
+		EventType:  h.config.EventTypePathOnPayload,
+	}, schema.NATS)
+}
+
+// publish returns false if the listener stopped before all events were delivered.
+func (h *Nats) publish(rotationEvents []events.SecretRotationEvent) bool {
+	for _, event := range rotationEvents {
+		select {
+		case h.eventChan <- event:
+			h.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-h.context.Done():
+			return false
+		}
+	}
+	return true
+}
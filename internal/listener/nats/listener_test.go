@@ -0,0 +1,166 @@
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats-server/v2/server"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nats-io/nkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func runServer(t *testing.T, opts *server.Options) *server.Server {
+	t.Helper()
+	opts.Host = "127.0.0.1"
+	opts.Port = -1
+	opts.NoLog = true
+	opts.NoSigs = true
+	s, err := server.NewServer(opts)
+	require.NoError(t, err)
+	go s.Start()
+	require.True(t, s.ReadyForConnections(5*time.Second))
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func TestCoreSubjectWithNKey(t *testing.T) {
+	user, err := nkeys.CreateUser()
+	require.NoError(t, err)
+	seed, err := user.Seed()
+	require.NoError(t, err)
+	pub, err := user.PublicKey()
+	require.NoError(t, err)
+	s := runServer(t, &server.Options{Nkeys: []*server.NkeyUser{{Nkey: pub}}})
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "nats", Namespace: "default"},
+		Data:       map[string][]byte{"seed": seed},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	eventChan := make(chan events.SecretRotationEvent)
+	listener, err := (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{
+		Type: "Nats",
+		Nats: &v1alpha1.NatsConfig{
+			Servers:                   []string{s.ClientURL()},
+			Subject:                   "secrets.>",
+			SecretIdentifierOnPayload: "name",
+			EventTypePathOnPayload:    "type",
+			Auth: &v1alpha1.NatsAuth{
+				NKeySeedRef: &v1alpha1.SecretKeySelector{Name: "nats", Namespace: "default", Key: "seed"},
+			},
+		},
+	}, c, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	defer func() { assert.NoError(t, listener.Stop()) }()
+
+	publisher, err := natsgo.Connect(s.ClientURL(), natsgo.Nkey(pub, user.Sign))
+	require.NoError(t, err)
+	defer publisher.Close()
+	require.NoError(t, publisher.Publish("secrets.rotated", []byte(`{"name":"db","type":"rotated"}`)))
+
+	event := receive(t, eventChan)
+	assert.Equal(t, "db", event.SecretIdentifier)
+	assert.Equal(t, "Nats/rotated", event.TriggerSource)
+}
+
+func TestJetStreamAcksAfterDelivery(t *testing.T) {
+	s := runServer(t, &server.Options{JetStream: true, StoreDir: t.TempDir()})
+	ctx := context.Background()
+
+	conn, err := natsgo.Connect(s.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "ROTATIONS", Subjects: []string{"rotations.>"}})
+	require.NoError(t, err)
+	_, err = js.Publish(ctx, "rotations.vault", []byte(`{"name":"db","namespace":"apps"}`))
+	require.NoError(t, err)
+	_, err = js.Publish(ctx, "rotations.vault", []byte(`not json`))
+	require.NoError(t, err)
+
+	eventChan := make(chan events.SecretRotationEvent)
+	listener, err := (&Provider{}).CreateListener(ctx, &v1alpha1.NotificationSource{
+		Type: "Nats",
+		Nats: &v1alpha1.NatsConfig{
+			Servers:                   []string{s.ClientURL()},
+			Subject:                   "rotations.>",
+			SecretIdentifierOnPayload: "name",
+			NamespacePathOnPayload:    "namespace",
+			JetStream:                 &v1alpha1.NatsJetStream{Stream: "ROTATIONS", Durable: "reloader", DeliverPolicy: "all"},
+		},
+	}, nil, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	defer func() { assert.NoError(t, listener.Stop()) }()
+
+	consumer, err := stream.Consumer(ctx, "reloader")
+	require.NoError(t, err)
+
+	// Nothing is acknowledged until the first event is received.
+	time.Sleep(100 * time.Millisecond)
+	info, err := consumer.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), info.AckFloor.Stream)
+	assert.NotZero(t, info.NumAckPending)
+
+	event := receive(t, eventChan)
+	assert.Equal(t, "db", event.SecretIdentifier)
+	assert.Equal(t, "apps", event.Namespace)
+	assert.Equal(t, "Nats", event.TriggerSource)
+
+	// Both the delivered and the unparsable message are acknowledged.
+	assert.Eventually(t, func() bool {
+		info, err := consumer.Info(ctx)
+		return err == nil && info.AckFloor.Stream == 2 && info.NumAckPending == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestCreateListenerValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source *v1alpha1.NotificationSource
+	}{
+		{name: "nil config", source: &v1alpha1.NotificationSource{Type: "Nats"}},
+		{name: "missing subject", source: &v1alpha1.NotificationSource{Type: "Nats", Nats: &v1alpha1.NatsConfig{
+			Servers: []string{"nats://localhost:4222"}, SecretIdentifierOnPayload: "name",
+		}}},
+		{name: "missing durable", source: &v1alpha1.NotificationSource{Type: "Nats", Nats: &v1alpha1.NatsConfig{
+			Servers: []string{"nats://localhost:4222"}, Subject: "a", SecretIdentifierOnPayload: "name",
+			JetStream: &v1alpha1.NatsJetStream{Stream: "S"},
+		}}},
+		{name: "empty auth", source: &v1alpha1.NotificationSource{Type: "Nats", Nats: &v1alpha1.NatsConfig{
+			Servers: []string{"nats://localhost:4222"}, Subject: "a", SecretIdentifierOnPayload: "name",
+			Auth: &v1alpha1.NatsAuth{},
+		}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := (&Provider{}).CreateListener(context.Background(), tc.source, nil, nil, logr.Discard())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func receive(t *testing.T, eventChan chan events.SecretRotationEvent) events.SecretRotationEvent {
+	t.Helper()
+	select {
+	case event := <-eventChan:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return events.SecretRotationEvent{}
+	}
+}
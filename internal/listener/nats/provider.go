@@ -0,0 +1,129 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Provider struct{}
+
+// CreateListener creates a NATS listener for core subjects or JetStream durable consumers.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.Nats == nil {
+		return nil, errors.New("nats config is nil")
+	}
+	cfg := config.Nats
+	if len(cfg.Servers) == 0 || cfg.Subject == "" {
+		return nil, errors.New("nats servers and subject are required")
+	}
+	if cfg.SecretIdentifierOnPayload == "" {
+		return nil, errors.New("nats identifierPathOnPayload is required")
+	}
+	if cfg.JetStream != nil && (cfg.JetStream.Stream == "" || cfg.JetStream.Durable == "") {
+		return nil, errors.New("nats jetStream stream and durable are required")
+	}
+
+	opts, err := connectOptions(ctx, client, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Nats{
+		config:    cfg,
+		context:   ctx,
+		cancel:    cancel,
+		client:    client,
+		eventChan: eventChan,
+		logger:    logger,
+		options:   opts,
+	}, nil
+}
+
+func connectOptions(ctx context.Context, c client.Client, cfg *v1alpha1.NatsConfig, logger logr.Logger) ([]natsgo.Option, error) {
+	opts := []natsgo.Option{
+		natsgo.Name("reloader"),
+		natsgo.MaxReconnects(-1),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				logger.Error(err, "Disconnected from nats")
+			}
+		}),
+		natsgo.ReconnectHandler(func(nc *natsgo.Conn) {
+			logger.Info("Reconnected to nats", "server", nc.ConnectedUrlRedacted())
+		}),
+	}
+
+	tlsConfig, err := tlsconfig.Build(ctx, c, cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("could not build nats tls config: %w", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, natsgo.Secure(tlsConfig))
+	}
+
+	if cfg.Auth != nil {
+		authOpt, err := authOption(ctx, c, cfg.Auth)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, authOpt)
+	}
+	return opts, nil
+}
+
+func authOption(ctx context.Context, c client.Client, auth *v1alpha1.NatsAuth) (natsgo.Option, error) {
+	switch {
+	case auth.NKeySeedRef != nil:
+		seed, err := resolvers.SecretKeyRef(ctx, c, auth.NKeySeedRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get nats nkey seed: %w", err)
+		}
+		kp, err := nkeys.FromSeed([]byte(seed))
+		if err != nil {
+			return nil, fmt.Errorf("invalid nats nkey seed: %w", err)
+		}
+		pub, err := kp.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid nats nkey seed: %w", err)
+		}
+		return natsgo.Nkey(pub, kp.Sign), nil
+	case auth.CredentialsRef != nil:
+		creds, err := resolvers.SecretKeyRef(ctx, c, auth.CredentialsRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get nats credentials: %w", err)
+		}
+		jwt, err := nkeys.ParseDecoratedJWT([]byte(creds))
+		if err != nil {
+			return nil, fmt.Errorf("invalid nats credentials: %w", err)
+		}
+		kp, err := nkeys.ParseDecoratedNKey([]byte(creds))
+		if err != nil {
+			return nil, fmt.Errorf("invalid nats credentials: %w", err)
+		}
+		return natsgo.UserJWT(func() (string, error) { return jwt, nil }, kp.Sign), nil
+	case auth.TokenRef != nil:
+		token, err := resolvers.SecretKeyRef(ctx, c, auth.TokenRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get nats token: %w", err)
+		}
+		return natsgo.Token(token), nil
+	default:
+		return nil, errors.New("nats auth requires one of nkeySeedSecretRef, credentialsSecretRef or tokenSecretRef")
+	}
+}
+
+func init() {
+	schema.RegisterProvider(schema.NATS, &Provider{})
+}
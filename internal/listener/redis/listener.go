@@ -0,0 +1,265 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/go-logr/logr"
+	goredis "github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	readBlock   = 5 * time.Second
+	readCount   = 10
+	retryPeriod = 5 * time.Second
+)
+
+// Redis consumes secret change events from Redis Pub/Sub channels or a Redis Stream.
+type Redis struct {
+	config      *v1alpha1.RedisConfig
+	context     context.Context
+	cancel      context.CancelFunc
+	client      client.Client
+	eventChan   chan events.SecretRotationEvent
+	logger      logr.Logger
+	redisClient *goredis.Client
+	consumer    string
+	done        chan struct{}
+}
+
+// Start begins consuming the configured channels or stream.
+func (h *Redis) Start() error {
+	if err := h.redisClient.Ping(h.context).Err(); err != nil {
+		return fmt.Errorf("could not connect to redis: %w", err)
+	}
+	h.done = make(chan struct{})
+	if h.config.Stream == nil {
+		pubsub := h.redisClient.Subscribe(h.context, h.config.Channels...)
+		if _, err := pubsub.Receive(h.context); err != nil {
+			_ = pubsub.Close()
+			return fmt.Errorf("could not subscribe to redis channels: %w", err)
+		}
+		h.logger.Info("Started subscribing to redis channels", "channels", h.config.Channels)
+		go h.subscribe(pubsub)
+		return nil
+	}
+
+	err := h.redisClient.XGroupCreateMkStream(h.context, h.config.Stream.Key, h.config.Stream.ConsumerGroup, h.startID()).Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("could not create redis consumer group: %w", err)
+	}
+	h.logger.Info("Started consuming redis stream", "stream", h.config.Stream.Key, "group", h.config.Stream.ConsumerGroup, "consumer", h.consumer)
+	go h.consumeStream()
+	return nil
+}
+
+// Stop stops consuming and closes the Redis connection.
+func (h *Redis) Stop() error {
+	h.cancel()
+	// Closing the client interrupts blocking stream reads.
+	err := h.redisClient.Close()
+	if h.done != nil {
+		<-h.done
+	}
+	return err
+}
+
+func (h *Redis) startID() string {
+	if h.config.Stream.StartID == "" {
+		return defaultStartID
+	}
+	return h.config.Stream.StartID
+}
+
+func (h *Redis) subscribe(pubsub *goredis.PubSub) {
+	defer close(h.done)
+	defer func() { _ = pubsub.Close() }()
+	ch := pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			rotationEvents, err := h.extractEvents(msg.Payload)
+			if err != nil {
+				h.logger.Error(err, "could not extract events from message", "channel", msg.Channel)
+				continue
+			}
+			if !h.publish(rotationEvents) {
+				return
+			}
+		case <-h.context.Done():
+			return
+		}
+	}
+}
+
+func (h *Redis) consumeStream() {
+	defer close(h.done)
+	// Entries delivered to this consumer before a restart are handled first,
+	// then entries left pending by other consumers are reclaimed.
+	if !h.readStream("0") || !h.reclaim() {
+		return
+	}
+	for {
+		if !h.readStream(">") {
+			return
+		}
+	}
+}
+
+// readStream reads entries of the consumer group. With id "0" it reads this consumer's pending entries once,
+// with id ">" it reads a batch of new entries. It returns false once the listener is stopped.
+func (h *Redis) readStream(id string) bool {
+	for {
+		streams, err := h.redisClient.XReadGroup(h.context, &goredis.XReadGroupArgs{
+			Group:    h.config.Stream.ConsumerGroup,
+			Consumer: h.consumer,
+			Streams:  []string{h.config.Stream.Key, id},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if h.context.Err() != nil {
+			return false
+		}
+		if errors.Is(err, goredis.Nil) {
+			return true
+		}
+		if err != nil {
+			h.logger.Error(err, "could not read redis stream", "stream", h.config.Stream.Key)
+			// Retry the same id, so pending entries are not skipped on transient errors.
+			if !h.wait() {
+				return false
+			}
+			continue
+		}
+		messages := []goredis.XMessage{}
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+		if !h.handleMessages(messages) {
+			return false
+		}
+		if id == ">" || len(messages) == 0 {
+			return true
+		}
+		id = messages[len(messages)-1].ID
+	}
+}
+
+func (h *Redis) reclaim() bool {
+	minIdle := defaultReclaimMinIdle
+	if h.config.Stream.ReclaimMinIdle != nil {
+		minIdle = h.config.Stream.ReclaimMinIdle.Duration
+	}
+	start := "0-0"
+	for {
+		messages, next, err := h.redisClient.XAutoClaim(h.context, &goredis.XAutoClaimArgs{
+			Stream:   h.config.Stream.Key,
+			Group:    h.config.Stream.ConsumerGroup,
+			Consumer: h.consumer,
+			MinIdle:  minIdle,
+			Start:    start,
+			Count:    readCount,
+		}).Result()
+		if h.context.Err() != nil {
+			return false
+		}
+		if err != nil {
+			h.logger.Error(err, "could not reclaim pending redis stream entries", "stream", h.config.Stream.Key)
+			if !h.wait() {
+				return false
+			}
+			continue
+		}
+		if len(messages) > 0 {
+			h.logger.Info("Reclaimed pending redis stream entries", "stream", h.config.Stream.Key, "count", len(messages))
+		}
+		if !h.handleMessages(messages) {
+			return false
+		}
+		if next == "0-0" || next == "" {
+			return true
+		}
+		start = next
+	}
+}
+
+// handleMessages acknowledges each entry once its events were delivered. Entries that cannot be parsed
+// are acknowledged as well so they are not redelivered forever.
+func (h *Redis) handleMessages(messages []goredis.XMessage) bool {
+	for _, msg := range messages {
+		data, err := h.entryPayload(msg)
+		if err == nil {
+			var rotationEvents []events.SecretRotationEvent
+			rotationEvents, err = h.extractEvents(data)
+			if err == nil && !h.publish(rotationEvents) {
+				return false
+			}
+		}
+		if err != nil {
+			h.logger.Error(err, "could not extract events from stream entry", "stream", h.config.Stream.Key, "id", msg.ID)
+		}
+		if err := h.redisClient.XAck(h.context, h.config.Stream.Key, h.config.Stream.ConsumerGroup, msg.ID).Err(); err != nil {
+			h.logger.Error(err, "could not ack stream entry", "stream", h.config.Stream.Key, "id", msg.ID)
+		}
+	}
+	return true
+}
+
+func (h *Redis) entryPayload(msg goredis.XMessage) (string, error) {
+	if h.config.Stream.PayloadField == "" {
+		data, err := json.Marshal(msg.Values)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	value, ok := msg.Values[h.config.Stream.PayloadField]
+	if !ok {
+		return "", fmt.Errorf("stream entry has no field %s", h.config.Stream.PayloadField)
+	}
+	return fmt.Sprint(value), nil
+}
+
+func (h *Redis) extractEvents(data string) ([]events.SecretRotationEvent, error) {
+	h.logger.V(1).Info("Processing Message", "Message", data)
+	return payload.ExtractEvents(data, payload.Paths{
+		Identifier: h.config.SecretIdentifierOnPayload,
+		Namespace:  h.config.NamespacePathOnPayload,
+		Timestamp:  h.config.TimestampPathOnPayload,
+		EventType:  h.config.EventTypePathOnPayload,
+	}, schema.REDIS)
+}
+
+// publish returns false if the listener stopped before all events were delivered.
+func (h *Redis) publish(rotationEvents []events.SecretRotationEvent) bool {
+	for _, event := range rotationEvents {
+		select {
+		case h.eventChan <- event:
+			h.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-h.context.Done():
+			return false
+		}
+	}
+	return true
+}
+
+func (h *Redis) wait() bool {
+	select {
+	case <-time.After(retryPeriod):
+		return true
+	case <-h.context.Done():
+		return false
+	}
+}
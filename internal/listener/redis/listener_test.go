@@ -0,0 +1,127 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPubSub(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("s3cr3t")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	eventChan := make(chan events.SecretRotationEvent)
+	listener, err := (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{
+		Type: "Redis",
+		Redis: &v1alpha1.RedisConfig{
+			Address:                   server.Addr(),
+			PasswordRef:               &v1alpha1.SecretKeySelector{Name: "redis", Namespace: "default", Key: "password"},
+			Channels:                  []string{"rotations"},
+			SecretIdentifierOnPayload: "secrets",
+		},
+	}, c, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	defer func() { assert.NoError(t, listener.Stop()) }()
+
+	server.Publish("rotations", `not json`)
+	server.Publish("rotations", `{"secrets":["db","api"]}`)
+	assert.Equal(t, "db", receive(t, eventChan).SecretIdentifier)
+	event := receive(t, eventChan)
+	assert.Equal(t, "api", event.SecretIdentifier)
+	assert.Equal(t, "Redis", event.TriggerSource)
+}
+
+func TestStreamReclaimsAndAcks(t *testing.T) {
+	server := miniredis.RunT(t)
+	ctx := context.Background()
+	rdb := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	defer func() { _ = rdb.Close() }()
+
+	require.NoError(t, rdb.XGroupCreateMkStream(ctx, "rotations", "reloader", "0").Err())
+	require.NoError(t, rdb.XAdd(ctx, &goredis.XAddArgs{Stream: "rotations", Values: map[string]any{"name": "db", "namespace": "apps"}}).Err())
+	// A consumer that crashed before acknowledging leaves the entry pending.
+	_, err := rdb.XReadGroup(ctx, &goredis.XReadGroupArgs{Group: "reloader", Consumer: "crashed", Streams: []string{"rotations", ">"}}).Result()
+	require.NoError(t, err)
+	require.NoError(t, rdb.XAdd(ctx, &goredis.XAddArgs{Stream: "rotations", Values: map[string]any{"name": "api", "namespace": "apps"}}).Err())
+
+	eventChan := make(chan events.SecretRotationEvent)
+	listener, err := (&Provider{}).CreateListener(ctx, &v1alpha1.NotificationSource{
+		Type: "Redis",
+		Redis: &v1alpha1.RedisConfig{
+			Address: server.Addr(),
+			Stream: &v1alpha1.RedisStream{
+				Key:            "rotations",
+				ConsumerGroup:  "reloader",
+				Consumer:       "reloader-0",
+				ReclaimMinIdle: &metav1.Duration{},
+			},
+			SecretIdentifierOnPayload: "name",
+			NamespacePathOnPayload:    "namespace",
+		},
+	}, nil, eventChan, logr.Discard())
+	require.NoError(t, err)
+	require.NoError(t, listener.Start())
+	defer func() { assert.NoError(t, listener.Stop()) }()
+
+	reclaimed := receive(t, eventChan)
+	assert.Equal(t, "db", reclaimed.SecretIdentifier)
+	assert.Equal(t, "apps", reclaimed.Namespace)
+	assert.Equal(t, "api", receive(t, eventChan).SecretIdentifier)
+
+	assert.Eventually(t, func() bool {
+		pending, err := rdb.XPending(ctx, "rotations", "reloader").Result()
+		return err == nil && pending.Count == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestCreateListenerValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *v1alpha1.RedisConfig
+	}{
+		{name: "nil config"},
+		{name: "missing address", config: &v1alpha1.RedisConfig{Channels: []string{"a"}, SecretIdentifierOnPayload: "name"}},
+		{name: "channels and stream", config: &v1alpha1.RedisConfig{
+			Address: "localhost:6379", Channels: []string{"a"}, SecretIdentifierOnPayload: "name",
+			Stream: &v1alpha1.RedisStream{Key: "a", ConsumerGroup: "g"},
+		}},
+		{name: "missing consumer group", config: &v1alpha1.RedisConfig{
+			Address: "localhost:6379", SecretIdentifierOnPayload: "name", Stream: &v1alpha1.RedisStream{Key: "a"},
+		}},
+		{name: "missing identifier path", config: &v1alpha1.RedisConfig{Address: "localhost:6379", Channels: []string{"a"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := (&Provider{}).CreateListener(context.Background(), &v1alpha1.NotificationSource{Type: "Redis", Redis: tc.config}, nil, nil, logr.Discard())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func receive(t *testing.T, eventChan chan events.SecretRotationEvent) events.SecretRotationEvent {
+	t.Helper()
+	select {
+	case event := <-eventChan:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return events.SecretRotationEvent{}
+	}
+}
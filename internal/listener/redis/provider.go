@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"github.com/external-secrets-inc/reloader/internal/util/tlsconfig"
+	"github.com/go-logr/logr"
+	goredis "github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultStartID        = "$"
+	defaultReclaimMinIdle = time.Minute
+)
+
+type Provider struct{}
+
+// CreateListener creates a Redis Pub/Sub or Stream listener.
+func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
+	if config == nil || config.Redis == nil {
+		return nil, errors.New("redis config is nil")
+	}
+	cfg := config.Redis
+	if (cfg.Address == "") == (cfg.Sentinel == nil) {
+		return nil, errors.New("exactly one of redis address or sentinel is required")
+	}
+	if (len(cfg.Channels) == 0) == (cfg.Stream == nil) {
+		return nil, errors.New("exactly one of redis channels or stream is required")
+	}
+	if cfg.Stream != nil && (cfg.Stream.Key == "" || cfg.Stream.ConsumerGroup == "") {
+		return nil, errors.New("redis stream key and consumerGroup are required")
+	}
+	if cfg.SecretIdentifierOnPayload == "" {
+		return nil, errors.New("redis identifierPathOnPayload is required")
+	}
+
+	redisClient, err := newRedisClient(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	consumer := ""
+	if cfg.Stream != nil {
+		consumer = cfg.Stream.Consumer
+		if consumer == "" {
+			if consumer, err = os.Hostname(); err != nil {
+				return nil, fmt.Errorf("could not default redis consumer name: %w", err)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Redis{
+		config:      cfg,
+		context:     ctx,
+		cancel:      cancel,
+		client:      client,
+		eventChan:   eventChan,
+		logger:      logger,
+		redisClient: redisClient,
+		consumer:    consumer,
+	}, nil
+}
+
+func newRedisClient(ctx context.Context, c client.Client, cfg *v1alpha1.RedisConfig) (*goredis.Client, error) {
+	tlsConfig, err := tlsconfig.Build(ctx, c, cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("could not build redis tls config: %w", err)
+	}
+	username, err := optionalSecret(ctx, c, cfg.UsernameRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get redis username: %w", err)
+	}
+	password, err := optionalSecret(ctx, c, cfg.PasswordRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get redis password: %w", err)
+	}
+
+	if cfg.Sentinel == nil {
+		return goredis.NewClient(&goredis.Options{
+			Addr:      cfg.Address,
+			DB:        int(cfg.DB),
+			Username:  username,
+			Password:  password,
+			TLSConfig: tlsConfig,
+		}), nil
+	}
+
+	sentinelPassword, err := optionalSecret(ctx, c, cfg.Sentinel.PasswordRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get redis sentinel password: %w", err)
+	}
+	return goredis.NewFailoverClient(&goredis.FailoverOptions{
+		MasterName:       cfg.Sentinel.MasterName,
+		SentinelAddrs:    cfg.Sentinel.Addresses,
+		SentinelPassword: sentinelPassword,
+		DB:               int(cfg.DB),
+		Username:         username,
+		Password:         password,
+		TLSConfig:        tlsConfig,
+	}), nil
+}
+
+func optionalSecret(ctx context.Context, c client.Client, ref *v1alpha1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	return resolvers.SecretKeyRef(ctx, c, ref)
+}
+
+func init() {
+	schema.RegisterProvider(schema.REDIS, &Provider{})
+}
@@ -1,11 +1,23 @@
 package listener
 
 import (
+	_ "github.com/external-secrets-inc/reloader/internal/listener/amqp"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/awssecretsmanager"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/azurekeyvault"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/cloudevents"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/eventgrid"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/gcpsecretmanager"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/hashivault"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/hashivaultpoll"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/k8sconfigmap"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/k8sobject"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/k8ssecret"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/kafka"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/mock"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/mqtt"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/nats"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/pubsub"
+	_ "github.com/external-secrets-inc/reloader/internal/listener/redis"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/sqs"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/tcp"
 	_ "github.com/external-secrets-inc/reloader/internal/listener/webhook"
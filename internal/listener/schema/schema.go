@@ -11,15 +11,26 @@ import (
 )
 
 const (
-	AWS_SQS               = "AwsSqs"
-	AZURE_EVENT_GRID      = "AzureEventGrid"
-	GOOGLE_PUB_SUB        = "GooglePubSub"
-	WEBHOOK               = "Webhook"
-	TCP_SOCKET            = "TCPSocket"
-	HASHICORP_VAULT       = "HashicorpVault"
-	MOCK                  = "Mock"
-	KUBERNETES_SECRET     = "KubernetesSecret"
-	KUBERNETES_CONFIG_MAP = "KubernetesConfigMap"
+	AWS_SQS                    = "AwsSqs"
+	AZURE_EVENT_GRID           = "AzureEventGrid"
+	GOOGLE_PUB_SUB             = "GooglePubSub"
+	WEBHOOK                    = "Webhook"
+	TCP_SOCKET                 = "TCPSocket"
+	HASHICORP_VAULT            = "HashicorpVault"
+	MOCK                       = "Mock"
+	KUBERNETES_SECRET          = "KubernetesSecret"
+	KUBERNETES_CONFIG_MAP      = "KubernetesConfigMap"
+	KUBERNETES_OBJECT          = "KubernetesObject"
+	CLOUD_EVENTS               = "CloudEvents"
+	KAFKA                      = "Kafka"
+	NATS                       = "Nats"
+	REDIS                      = "Redis"
+	AMQP                       = "Amqp"
+	MQTT                       = "Mqtt"
+	AWS_SECRETS_MANAGER_POLL   = "AwsSecretsManagerPoll"
+	GOOGLE_SECRET_MANAGER_POLL = "GoogleSecretManagerPoll"
+	HASHICORP_VAULT_POLL       = "HashicorpVaultPoll"
+	AZURE_KEY_VAULT_POLL       = "AzureKeyVaultPoll"
 )
 
 var (
@@ -5,13 +5,12 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"time"
 
 	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
 	"github.com/external-secrets-inc/reloader/internal/events"
 	"github.com/external-secrets-inc/reloader/internal/listener/schema"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
 	"github.com/go-logr/logr"
-	"github.com/tidwall/gjson"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -63,32 +62,35 @@ func (h *TCPSocket) handleConnection(listener net.Listener) {
 
 type ProcessFn func(message []byte)
 
+const defaultIdentifierPath = "0.data.ObjectName"
+
 func (h *TCPSocket) defaultProcess(message []byte) {
 	msgString := string(message)
 	h.logger.V(1).Info("Processing Message", "Message", msgString)
-	if !gjson.Valid(msgString) {
-		h.logger.Error(fmt.Errorf("invalid json"), "could not parse json", "Message", msgString)
-		return
+	identifierPath := h.config.SecretIdentifierOnPayload
+	if identifierPath == "" {
+		identifierPath = defaultIdentifierPath
 	}
-	res := gjson.Get(msgString, h.config.SecretIdentifierOnPayload)
-	if !res.Exists() {
-		h.logger.Error(fmt.Errorf("secretIdentifier not found in message"), "error when finding path", "Message", msgString, "Secret Identifier", h.config.SecretIdentifierOnPayload)
+	rotationEvents, err := payload.ExtractEvents(msgString, payload.Paths{
+		Identifier: identifierPath,
+		Namespace:  h.config.NamespacePathOnPayload,
+		Timestamp:  h.config.TimestampPathOnPayload,
+		EventType:  h.config.EventTypePathOnPayload,
+	}, schema.TCP_SOCKET)
+	if err != nil {
+		h.logger.Error(err, "could not extract events from message", "Message", msgString, "Secret Identifier", identifierPath)
 		return
 	}
-	val := res.Value()
-	switch v := val.(type) {
-	case string:
-		event := events.SecretRotationEvent{
-			SecretIdentifier:  v,
-			RotationTimestamp: time.Now().Format("2006-01-02-15-04-05.000"),
-			TriggerSource:     schema.TCP_SOCKET,
+	for _, event := range rotationEvents {
+		select {
+		case h.eventChan <- event:
+			h.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-h.context.Done():
+			return
 		}
-		h.eventChan <- event
-		h.logger.V(1).Info("Published event to eventChan", "Event", event)
-	default:
-		h.logger.Error(fmt.Errorf("secretIdentifier must be type string"), "Identifier", v)
 	}
 }
+
 func (h *TCPSocket) readMessage(conn net.Conn) {
 
 	buf := make([]byte, 4096)
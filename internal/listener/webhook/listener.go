@@ -16,8 +16,8 @@ import (
 	"github.com/external-secrets-inc/reloader/internal/events"
 	"github.com/external-secrets-inc/reloader/internal/listener/schema"
 	"github.com/external-secrets-inc/reloader/internal/util"
+	payloadutil "github.com/external-secrets-inc/reloader/internal/util/payload"
 	"github.com/go-logr/logr"
-	"github.com/tidwall/gjson"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -43,8 +43,14 @@ type WebhookListener struct {
 	logger     logr.Logger
 	client     client.Client
 	retryQueue chan *RetryMessage
+	oidc       *oidcVerifier
+	mux        *http.ServeMux
+	extractFn  ExtractFn
 }
 
+// ExtractFn builds the events to publish out of a request headers and payload.
+type ExtractFn func(header http.Header, payload string) ([]events.SecretRotationEvent, error)
+
 // Start initiates the WebhookListener to begin listening for incoming webhook requests.
 func (h *WebhookListener) Start() error {
 	h.logger.Info("Starting Webhook Listener...")
@@ -74,8 +80,7 @@ func (h *WebhookListener) Stop() error {
 }
 
 func (h *WebhookListener) webhookHandler(w http.ResponseWriter, r *http.Request) {
-	var err error
-	err = h.authenticate(r.Header)
+	claims, err := h.authenticate(r.Header)
 	if err != nil {
 		h.logger.Error(err, "Couldn't authenticate request")
 		w.WriteHeader(http.StatusUnauthorized)
@@ -99,28 +104,37 @@ func (h *WebhookListener) webhookHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	identifierPath := h.getIdentifierPath()
-
-	secretIdentifier, err := getSecretIdentifierFromPayload(payload, identifierPath)
+	rotationEvents, err := h.extractFn(r.Header, payload)
 	if err != nil {
-		message := fmt.Sprintf("Secret Identifier not found on payload."+
-			"Ensure that your secret is on the following path: %s", identifierPath)
-		h.logger.Error(err, message)
-
+		h.logger.Error(err, "Couldn't extract events from payload")
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = fmt.Fprintln(w, message)
+		_, _ = fmt.Fprintln(w, err.Error())
 		return
 	}
 
-	if event, err := h.processSecret(secretIdentifier); err != nil {
-		message := "Failed to process event"
-		h.logger.Error(err, message)
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = fmt.Fprintln(w, message)
+	for _, event := range rotationEvents {
+		if err := h.authorize(claims, event.SecretIdentifier); err != nil {
+			h.logger.Error(err, "Caller is not allowed to trigger secret", "SecretIdentifier", event.SecretIdentifier)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprintln(w, "Caller is not allowed to trigger this secret")
+			return
+		}
+	}
+
+	failed := false
+	for _, event := range rotationEvents {
+		if err := h.processEvent(event); err != nil {
+			h.logger.Error(err, "Failed to process event", "SecretIdentifier", event.SecretIdentifier)
+			failed = true
 
-		if h.config != nil && h.config.RetryPolicy != nil {
-			h.retryQueue <- &RetryMessage{event: event, currentRun: 1, retryAt: time.Now()}
+			if h.config != nil && h.config.RetryPolicy != nil {
+				h.retryQueue <- &RetryMessage{event: event, currentRun: 1, retryAt: time.Now()}
+			}
 		}
+	}
+	if failed {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, "Failed to process event")
 		return
 	}
 
@@ -128,31 +142,64 @@ func (h *WebhookListener) webhookHandler(w http.ResponseWriter, r *http.Request)
 	_, _ = fmt.Fprintln(w, "")
 }
 
-func (h *WebhookListener) authenticate(header http.Header) error {
+// authenticate validates the request credentials. If the request was authenticated with an OIDC token,
+// its claims are returned so they can be used to authorize the secret identifiers.
+func (h *WebhookListener) authenticate(header http.Header) (map[string]any, error) {
 	if h.config == nil || h.config.Auth == nil {
-		return nil
+		return nil, nil
 	}
 
 	basicAuth := h.config.Auth.BasicAuth
 	bearer := h.config.Auth.BearerToken
 
-	if basicAuth == nil && bearer == nil {
-		return nil
+	if basicAuth == nil && bearer == nil && h.oidc == nil {
+		return nil, nil
 	}
 
 	authHeader := strings.Split(header.Get("Authorization"), " ")
 	if len(authHeader) != 2 {
-		return errors.New("malformed authorization header. Use `Bearer <token>` or `Basic <token>`")
+		return nil, errors.New("malformed authorization header. Use `Bearer <token>` or `Basic <token>`")
+	}
+
+	if strings.Contains(strings.ToLower(authHeader[0]), "basic") {
+		if basicAuth == nil {
+			return nil, errors.New("basic authentication is not enabled")
+		}
+		return nil, authenticateWithBasicAuth(h.ctx, h.client, authHeader[1], basicAuth, h.logger)
 	}
 
-	if basicAuth != nil && strings.Contains(strings.ToLower(authHeader[0]), "basic") {
-		return authenticateWithBasicAuth(h.ctx, h.client, authHeader[1], basicAuth, h.logger)
+	if bearer != nil {
+		err := authenticateWithBearer(h.ctx, h.client, authHeader[1], bearer, h.logger)
+		if err == nil || h.oidc == nil {
+			return nil, err
+		}
 	}
 
-	return authenticateWithBearer(h.ctx, h.client, authHeader[1], bearer, h.logger)
+	if h.oidc == nil {
+		return nil, errors.New("bearer authentication is not enabled")
+	}
+
+	return h.oidc.verify(h.ctx, authHeader[1])
+}
+
+// authorize checks if the authenticated caller is allowed to trigger the secret identifier.
+// IdentifierRestrictions apply to every authentication method: static credentials carry no claims, so they are rejected.
+func (h *WebhookListener) authorize(claims map[string]any, secretIdentifier string) error {
+	if h.oidc == nil {
+		return nil
+	}
+
+	return h.oidc.authorize(claims, secretIdentifier)
 }
 
 func (h *WebhookListener) createHandler() {
+	h.mux = http.NewServeMux()
+	h.mux.HandleFunc(fmt.Sprintf("POST %s", h.Path()), h.webhookHandler)
+	h.server.Handler = h.mux
+}
+
+// Path returns the path the webhook receives notifications on.
+func (h *WebhookListener) Path() string {
 	path := defaultPath
 	if h.config != nil && h.config.Path != "" {
 		path = h.config.Path
@@ -160,18 +207,43 @@ func (h *WebhookListener) createHandler() {
 			path = "/" + path
 		}
 	}
-	mux := http.NewServeMux()
-	mux.HandleFunc(fmt.Sprintf("POST %s", path), h.webhookHandler)
-	h.server.Handler = mux
+	return path
+}
+
+// HandleFunc registers an additional handler on the webhook server, e.g. for protocol handshakes.
+func (h *WebhookListener) HandleFunc(pattern string, handler http.HandlerFunc) {
+	h.mux.HandleFunc(pattern, handler)
+}
+
+// SetExtractFn replaces how events are extracted from incoming requests.
+func (h *WebhookListener) SetExtractFn(fn ExtractFn) {
+	h.extractFn = fn
+}
+
+// defaultExtract extracts events from the payload using the configured gjson paths.
+func (h *WebhookListener) defaultExtract(_ http.Header, payload string) ([]events.SecretRotationEvent, error) {
+	paths := h.getPayloadPaths()
+	rotationEvents, err := payloadutil.ExtractEvents(payload, paths, schema.WEBHOOK)
+	if err != nil {
+		return nil, fmt.Errorf("secret Identifier not found on payload. "+
+			"Ensure that your secret is on the following path: %s: %w", paths.Identifier, err)
+	}
+	return rotationEvents, nil
 }
 
-func (h *WebhookListener) getIdentifierPath() string {
-	identifierPath := defautlIdentifierPath
-	if h.config != nil && h.config.SecretIdentifierOnPayload != "" {
-		identifierPath = h.config.SecretIdentifierOnPayload
+func (h *WebhookListener) getPayloadPaths() payloadutil.Paths {
+	paths := payloadutil.Paths{Identifier: defautlIdentifierPath}
+	if h.config == nil {
+		return paths
+	}
+	if h.config.SecretIdentifierOnPayload != "" {
+		paths.Identifier = h.config.SecretIdentifierOnPayload
 	}
+	paths.Namespace = h.config.NamespacePathOnPayload
+	paths.Timestamp = h.config.TimestampPathOnPayload
+	paths.EventType = h.config.EventTypePathOnPayload
 
-	return identifierPath
+	return paths
 }
 
 func (h *WebhookListener) handleErrors() {
@@ -245,26 +317,6 @@ func parsePayloadToString(body io.ReadCloser) (string, error) {
 	return string(b), nil
 }
 
-func getSecretIdentifierFromPayload(payload, identifierPath string) (string, error) {
-	secretIdentifier := gjson.Get(payload, identifierPath)
-
-	if !secretIdentifier.Exists() {
-		err := errors.New("secret not found on event")
-		return "", err
-	}
-
-	return secretIdentifier.String(), nil
-}
-
-func (h *WebhookListener) processSecret(secretIdentifier string) (events.SecretRotationEvent, error) {
-	event := events.SecretRotationEvent{
-		SecretIdentifier:  secretIdentifier,
-		RotationTimestamp: time.Now().Format("2006-01-02-15-04-05.000"),
-		TriggerSource:     schema.WEBHOOK,
-	}
-	return event, h.processEvent(event)
-}
-
 func (h *WebhookListener) processEvent(event events.SecretRotationEvent) error {
 	select {
 	case h.eventChan <- event:
@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const jwksCacheTTL = 5 * time.Minute
+const maxJWKSSize = 1 << 20
+
+var supportedSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// oidcVerifier validates JWT/OIDC ID tokens against a JWKS and the configured claims.
+type oidcVerifier struct {
+	config     *v1alpha1.OIDCAuth
+	client     client.Client
+	httpClient *http.Client
+	logger     logr.Logger
+
+	// allowed holds the compiled AllowedIdentifiers of every IdentifierRestriction, in the same order.
+	allowed [][]*regexp.Regexp
+
+	mu        sync.Mutex
+	keys      *jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+func newOIDCVerifier(config *v1alpha1.OIDCAuth, k8sClient client.Client, logger logr.Logger) (*oidcVerifier, error) {
+	if config.IssuerURL == "" {
+		return nil, errors.New("oidc issuerURL is required")
+	}
+	sources := 0
+	if config.JWKS.URL != "" {
+		sources++
+	}
+	if config.JWKS.SecretRef != nil {
+		sources++
+	}
+	if config.JWKS.ConfigMapRef != nil {
+		sources++
+	}
+	if sources != 1 {
+		return nil, errors.New("exactly one of oidc jwks url, secretRef or configMapRef must be set")
+	}
+	allowed := make([][]*regexp.Regexp, 0, len(config.IdentifierRestrictions))
+	for _, restriction := range config.IdentifierRestrictions {
+		patterns := make([]*regexp.Regexp, 0, len(restriction.AllowedIdentifiers))
+		for _, pattern := range restriction.AllowedIdentifiers {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed identifier pattern %q: %w", pattern, err)
+			}
+			patterns = append(patterns, re)
+		}
+		allowed = append(allowed, patterns)
+	}
+
+	return &oidcVerifier{
+		config:     config,
+		client:     k8sClient,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		logger:     logger,
+		allowed:    allowed,
+	}, nil
+}
+
+// verify validates the token signature, expiry and claims. It returns all claims found on the token.
+func (v *oidcVerifier) verify(ctx context.Context, rawToken string) (map[string]any, error) {
+	token, err := jwt.ParseSigned(rawToken, supportedSignatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	keys, err := v.keySet(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	standard := jwt.Claims{}
+	claims := map[string]any{}
+	if err := token.Claims(*keys, &standard, &claims); err != nil {
+		// Keys might have been rotated on the issuer. Refresh remote keys once before failing.
+		if v.config.JWKS.URL == "" {
+			return nil, fmt.Errorf("invalid token signature: %w", err)
+		}
+		keys, err = v.keySet(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := token.Claims(*keys, &standard, &claims); err != nil {
+			return nil, fmt.Errorf("invalid token signature: %w", err)
+		}
+	}
+
+	if standard.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+	err = standard.Validate(jwt.Expected{
+		Issuer:      v.config.IssuerURL,
+		AnyAudience: v.config.Audiences,
+		Time:        time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token claims: %w", err)
+	}
+
+	for name, want := range v.config.RequiredClaims {
+		if !claimMatches(claims[name], []string{want}) {
+			return nil, fmt.Errorf("required claim %s does not match", name)
+		}
+	}
+
+	return claims, nil
+}
+
+// authorize checks if a caller with the given claims may trigger the secret identifier.
+// Callers without claims, e.g. authenticated with static credentials, never match a restriction.
+func (v *oidcVerifier) authorize(claims map[string]any, identifier string) error {
+	if len(v.config.IdentifierRestrictions) == 0 {
+		return nil
+	}
+	for i, restriction := range v.config.IdentifierRestrictions {
+		if !claimMatches(claims[restriction.Claim], restriction.Values) {
+			continue
+		}
+		for _, re := range v.allowed[i] {
+			if re.MatchString(identifier) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("caller is not allowed to trigger %s", identifier)
+}
+
+func (v *oidcVerifier) keySet(ctx context.Context, forceRefresh bool) (*jose.JSONWebKeySet, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// Secrets and ConfigMaps are read from the client cache, so they are always loaded.
+	if v.config.JWKS.URL != "" && !forceRefresh && v.keys != nil && time.Since(v.fetchedAt) < jwksCacheTTL {
+		return v.keys, nil
+	}
+
+	data, err := v.loadJWKS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("jwks contains no keys")
+	}
+
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	return keys, nil
+}
+
+func (v *oidcVerifier) loadJWKS(ctx context.Context) ([]byte, error) {
+	source := v.config.JWKS
+	switch {
+	case source.URL != "":
+		return v.fetchRemoteJWKS(ctx, source.URL)
+	case source.SecretRef != nil:
+		data, err := decodeSecret(ctx, v.client, source.SecretRef, v.logger)
+		return []byte(data), err
+	case source.ConfigMapRef != nil:
+		return decodeConfigMap(ctx, v.client, source.ConfigMapRef)
+	default:
+		return nil, errors.New("no jwks source configured")
+	}
+}
+
+func (v *oidcVerifier) fetchRemoteJWKS(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwks request: %w", err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close() //nolint
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+// claimMatches returns true if the claim equals any of the values.
+// Array claims match if any of their elements equals any of the values.
+func claimMatches(claim any, values []string) bool {
+	switch c := claim.(type) {
+	case nil:
+		return false
+	case []any:
+		for _, element := range c {
+			if claimMatches(element, values) {
+				return true
+			}
+		}
+		return false
+	case string:
+		return slices.Contains(values, c)
+	case bool:
+		return slices.Contains(values, strconv.FormatBool(c))
+	case float64:
+		return slices.Contains(values, strconv.FormatFloat(c, 'f', -1, 64))
+	default:
+		return false
+	}
+}
+
+func decodeConfigMap(ctx context.Context, k8sClient client.Client, config *v1alpha1.ConfigMapKeySelector) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Name: config.Name, Namespace: config.Namespace}
+	if err := k8sClient.Get(ctx, key, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get configmap: %w", err)
+	}
+
+	if data, ok := configMap.Data[config.Key]; ok {
+		return []byte(data), nil
+	}
+	if data, ok := configMap.BinaryData[config.Key]; ok {
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("%s not found in configmap %s", config.Key, config.Name)
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+func TestOIDCVerifier(t *testing.T) {
+	ctx := context.TODO()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"},
+	}})
+	require.NoError(t, err)
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwks", Namespace: "default"},
+		Data:       map[string]string{"jwks.json": string(jwks)},
+	}).Build()
+
+	config := &v1alpha1.OIDCAuth{
+		IssuerURL:      testIssuer,
+		Audiences:      []string{"reloader"},
+		RequiredClaims: map[string]string{"repository": "org/repo"},
+		JWKS: v1alpha1.JWKSSource{
+			ConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "jwks", Namespace: "default", Key: "jwks.json"},
+		},
+		IdentifierRestrictions: []v1alpha1.OIDCIdentifierRestriction{
+			{Claim: "sub", Values: []string{"ci"}, AllowedIdentifiers: []string{"^ci/.*"}},
+		},
+	}
+	verifier, err := newOIDCVerifier(config, c, logr.Discard())
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+	require.NoError(t, err)
+	sign := func(claims jwt.Claims, extra map[string]any) string {
+		token, err := jwt.Signed(signer).Claims(claims).Claims(extra).Serialize()
+		require.NoError(t, err)
+		return token
+	}
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "ci",
+		Audience: jwt.Audience{"reloader"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	extra := map[string]any{"repository": "org/repo"}
+
+	testCases := []struct {
+		name    string
+		token   func() string
+		wantErr bool
+	}{
+		{
+			name:  "valid token",
+			token: func() string { return sign(valid, extra) },
+		},
+		{
+			name: "expired token",
+			token: func() string {
+				claims := valid
+				claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return sign(claims, extra)
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing expiry",
+			token: func() string {
+				claims := valid
+				claims.Expiry = nil
+				return sign(claims, extra)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := valid
+				claims.Issuer = "https://other.example.com"
+				return sign(claims, extra)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := valid
+				claims.Audience = jwt.Audience{"other"}
+				return sign(claims, extra)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing required claim",
+			token:   func() string { return sign(valid, map[string]any{}) },
+			wantErr: true,
+		},
+		{
+			name: "unknown signing key",
+			token: func() string {
+				other, err := rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				s, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: other}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+				require.NoError(t, err)
+				token, err := jwt.Signed(s).Claims(valid).Claims(extra).Serialize()
+				require.NoError(t, err)
+				return token
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := verifier.verify(ctx, tc.token())
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, verifier.authorize(claims, "ci/database"))
+			assert.Error(t, verifier.authorize(claims, "prod/database"))
+			assert.Error(t, verifier.authorize(nil, "ci/database"))
+		})
+	}
+}
+
+func TestNewOIDCVerifierInvalidPattern(t *testing.T) {
+	config := &v1alpha1.OIDCAuth{
+		IssuerURL: testIssuer,
+		JWKS:      v1alpha1.JWKSSource{URL: "https://issuer.example.com/jwks"},
+		IdentifierRestrictions: []v1alpha1.OIDCIdentifierRestriction{
+			{Claim: "sub", Values: []string{"ci"}, AllowedIdentifiers: []string{"^ci/("}},
+		},
+	}
+	_, err := newOIDCVerifier(config, nil, logr.Discard())
+	assert.ErrorContains(t, err, "invalid allowed identifier pattern")
+}
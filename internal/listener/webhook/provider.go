@@ -15,21 +15,34 @@ import (
 type Provider struct {
 }
 
-// NewWebhookListener creates a new Listener that listens for webhook notifications based on the provided configuration and event channel.
+// CreateListener creates a new Listener that listens for webhook notifications based on the provided configuration and event channel.
 func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.NotificationSource, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (schema.Listener, error) {
 	if config == nil || config.Webhook == nil {
 		return nil, errors.New("webhook config is nil")
 	}
-	server, err := createServer(config.Webhook)
+	return NewWebhookListener(ctx, config.Webhook, client, eventChan, logger)
+}
+
+// NewWebhookListener initializes a new webhook listener in a way other components can consume.
+func NewWebhookListener(ctx context.Context, config *v1alpha1.WebhookConfig, client client.Client, eventChan chan events.SecretRotationEvent, logger logr.Logger) (*WebhookListener, error) {
+	server, err := createServer(config)
 	if err != nil {
 		logger.Error(err, "failed to create webhook server")
 		return nil, fmt.Errorf("failed to create webhook server: %w", err)
 	}
 
+	var oidc *oidcVerifier
+	if config != nil && config.Auth != nil && config.Auth.OIDC != nil {
+		oidc, err = newOIDCVerifier(config.Auth.OIDC, client, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create oidc verifier: %w", err)
+		}
+	}
+
 	childCtx, cancel := context.WithCancel(ctx)
 
 	listener := &WebhookListener{
-		config:     config.Webhook,
+		config:     config,
 		eventChan:  eventChan,
 		ctx:        childCtx,
 		cancel:     cancel,
@@ -37,8 +50,10 @@ func (p *Provider) CreateListener(ctx context.Context, config *v1alpha1.Notifica
 		server:     server,
 		client:     client,
 		retryQueue: make(chan *RetryMessage),
+		oidc:       oidc,
 	}
 
+	listener.SetExtractFn(listener.defaultExtract)
 	listener.createHandler()
 
 	return listener, nil
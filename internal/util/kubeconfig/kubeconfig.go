@@ -0,0 +1,106 @@
+package kubeconfig
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ServiceAccountTokenTTL is the lifetime of the ServiceAccount tokens requested for Auth.ServiceAccountRef.
+const ServiceAccountTokenTTL = time.Hour
+
+// RESTConfig returns the config to connect to the cluster at serverURL with the given auth.
+// If auth is nil, the default config of the reloader is used.
+// Referenced secrets and ServiceAccounts are read from the cluster of c.
+func RESTConfig(ctx context.Context, c client.Client, serverURL string, auth *v1alpha1.KubernetesAuth) (*rest.Config, error) {
+	if auth == nil {
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default config: %w", err)
+		}
+		return cfg, nil
+	}
+	if auth.KubeConfigRef != nil {
+		cfg, err := resolvers.SecretKeyRef(ctx, c, &auth.KubeConfigRef.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return clientcmd.RESTConfigFromKubeConfig([]byte(cfg))
+	}
+
+	if serverURL == "" {
+		return nil, errors.New("no server URL provided")
+	}
+
+	cfg := &rest.Config{
+		Host: serverURL,
+	}
+
+	if auth.CABundle != "" {
+		ca, err := base64decode([]byte(auth.CABundle))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode ca bundle: %w", err)
+		}
+		cfg.TLSClientConfig = rest.TLSClientConfig{
+			Insecure: false,
+			CAData:   ca,
+		}
+	}
+
+	switch {
+	case auth.TokenRef != nil:
+		token, err := resolvers.SecretKeyRef(ctx, c, &auth.TokenRef.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch Auth.Token.BearerToken: %w", err)
+		}
+		cfg.BearerToken = token
+	case auth.ServiceAccountRef != nil:
+		token, err := resolvers.ServiceAccountToken(ctx, nil, auth.ServiceAccountRef, ServiceAccountTokenTTL)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch Auth.ServiceAccount: %w", err)
+		}
+		cfg.BearerToken = token
+	default:
+		return nil, errors.New("no auth provider given")
+	}
+
+	return cfg, nil
+}
+
+func base64decode(cert []byte) ([]byte, error) {
+	if c, err := parseCertificateBytes(cert); err == nil {
+		return c, nil
+	}
+
+	// try b64 decoding and test for validity again...
+	certificate, err := base64.StdEncoding.DecodeString(string(cert))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+
+	return parseCertificateBytes(certificate)
+}
+
+func parseCertificateBytes(certBytes []byte) ([]byte, error) {
+	block, _ := pem.Decode(certBytes)
+	if block == nil {
+		return nil, errors.New("failed to parse the new certificate, not valid pem data")
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("failed to validate certificate: %w", err)
+	}
+
+	return certBytes, nil
+}
@@ -0,0 +1,105 @@
+package kubeconfig
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+users:
+- name: remote
+  user:
+    token: kubeconfig-token
+`
+
+func TestRESTConfig(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "reloader"},
+		Data: map[string][]byte{
+			"token":      []byte("bearer-token"),
+			"kubeconfig": []byte(testKubeConfig),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	ref := func(key string) v1alpha1.SecretKeySelector {
+		return v1alpha1.SecretKeySelector{Name: "remote", Namespace: "reloader", Key: key}
+	}
+
+	testCases := []struct {
+		name      string
+		serverURL string
+		auth      *v1alpha1.KubernetesAuth
+		wantHost  string
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:      "token ref",
+			serverURL: "https://api.example.com",
+			auth:      &v1alpha1.KubernetesAuth{TokenRef: &v1alpha1.TokenRef{SecretRef: ref("token")}},
+			wantHost:  "https://api.example.com",
+			wantToken: "bearer-token",
+		},
+		{
+			name:      "kubeconfig ref",
+			auth:      &v1alpha1.KubernetesAuth{KubeConfigRef: &v1alpha1.KubeConfigRef{SecretRef: ref("kubeconfig")}},
+			wantHost:  "https://remote.example.com",
+			wantToken: "kubeconfig-token",
+		},
+		{
+			name:    "missing server URL",
+			auth:    &v1alpha1.KubernetesAuth{TokenRef: &v1alpha1.TokenRef{SecretRef: ref("token")}},
+			wantErr: true,
+		},
+		{
+			name:      "missing secret key",
+			serverURL: "https://api.example.com",
+			auth:      &v1alpha1.KubernetesAuth{TokenRef: &v1alpha1.TokenRef{SecretRef: ref("missing")}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid ca bundle",
+			serverURL: "https://api.example.com",
+			auth:      &v1alpha1.KubernetesAuth{CABundle: "invalid", TokenRef: &v1alpha1.TokenRef{SecretRef: ref("token")}},
+			wantErr:   true,
+		},
+		{
+			name:      "no auth provider",
+			serverURL: "https://api.example.com",
+			auth:      &v1alpha1.KubernetesAuth{},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := RESTConfig(context.Background(), c, tc.serverURL, tc.auth)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantHost, cfg.Host)
+			assert.Equal(t, tc.wantToken, cfg.BearerToken)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package match
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Evaluate checks the value at strategy.Path of obj against all conditions of strategy.
+// Path is a dotted path, e.g. `.metadata.name`. A missing field is matched as an empty string.
+// A nil strategy always matches.
+func Evaluate(strategy *v1alpha1.MatchStrategy, obj map[string]any) (bool, error) {
+	if strategy == nil {
+		return true, nil
+	}
+	value, err := Value(obj, strategy.Path)
+	if err != nil {
+		return false, err
+	}
+	for _, condition := range strategy.Conditions {
+		ok, err := evaluate(condition, value)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Value returns the value at the dotted path of obj as a string.
+// Non string values are encoded as JSON.
+func Value(obj map[string]any, path string) (string, error) {
+	fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if !found || value == nil {
+		return "", nil
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("could not encode value at %q: %w", path, err)
+	}
+	return string(b), nil
+}
+
+func evaluate(condition v1alpha1.Condition, value string) (bool, error) {
+	switch condition.Operation {
+	case v1alpha1.ConditionOperationEqual:
+		return value == condition.Value, nil
+	case v1alpha1.ConditionOperationNotEqual:
+		return value != condition.Value, nil
+	case v1alpha1.ConditionOperationContains:
+		return strings.Contains(value, condition.Value), nil
+	case v1alpha1.ConditionOperationNotContains:
+		return !strings.Contains(value, condition.Value), nil
+	case v1alpha1.ConditionOperationIn:
+		re, err := regexp.Compile(condition.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q: %w", condition.Value, err)
+		}
+		return re.MatchString(value), nil
+	}
+	return false, fmt.Errorf("unsupported condition operation %q", condition.Operation)
+}
+
+// EventFields returns the fields of event a MatchStrategy path can refer to,
+// e.g. `.secretIdentifier` or `.triggerSource`.
+func EventFields(event events.SecretRotationEvent) map[string]any {
+	return map[string]any{
+		"secretIdentifier":  event.SecretIdentifier,
+		"rotationTimestamp": event.RotationTimestamp,
+		"triggerSource":     event.TriggerSource,
+		"namespace":         event.Namespace,
+	}
+}
@@ -0,0 +1,59 @@
+package match
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	obj := map[string]any{
+		"secretIdentifier": "prod/db/password",
+		"metadata":         map[string]any{"generation": int64(3)},
+	}
+	condition := func(op v1alpha1.ConditionOperation, value string) v1alpha1.Condition {
+		return v1alpha1.Condition{Operation: op, Value: value}
+	}
+
+	testCases := []struct {
+		name     string
+		strategy *v1alpha1.MatchStrategy
+		want     bool
+		wantErr  bool
+	}{
+		{name: "nil strategy", want: true},
+		{name: "equal", strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{condition(v1alpha1.ConditionOperationEqual, "prod/db/password")}}, want: true},
+		{name: "not equal", strategy: &v1alpha1.MatchStrategy{Path: "secretIdentifier", Conditions: []v1alpha1.Condition{condition(v1alpha1.ConditionOperationNotEqual, "prod/db/password")}}},
+		{
+			name: "all conditions must match",
+			strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{
+				condition(v1alpha1.ConditionOperationContains, "prod/"),
+				condition(v1alpha1.ConditionOperationNotContains, "/api/"),
+				condition(v1alpha1.ConditionOperationIn, `^prod/[a-z]+/password$`),
+			}},
+			want: true,
+		},
+		{name: "one condition fails", strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{
+			condition(v1alpha1.ConditionOperationContains, "prod/"),
+			condition(v1alpha1.ConditionOperationContains, "staging/"),
+		}}},
+		{name: "non string value", strategy: &v1alpha1.MatchStrategy{Path: ".metadata.generation", Conditions: []v1alpha1.Condition{condition(v1alpha1.ConditionOperationEqual, "3")}}, want: true},
+		{name: "missing field", strategy: &v1alpha1.MatchStrategy{Path: ".missing", Conditions: []v1alpha1.Condition{condition(v1alpha1.ConditionOperationEqual, "")}}, want: true},
+		{name: "invalid regular expression", strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{condition(v1alpha1.ConditionOperationIn, "(")}}, wantErr: true},
+		{name: "unsupported operation", strategy: &v1alpha1.MatchStrategy{Path: ".secretIdentifier", Conditions: []v1alpha1.Condition{condition("GreaterThan", "1")}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Evaluate(tc.strategy, obj)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/tidwall/gjson"
+)
+
+const TimestampFormat = "2006-01-02-15-04-05.000"
+
+// Paths contains the gjson paths used to build events out of a payload.
+// Only Identifier is required. When Identifier returns an array, one event is created per element,
+// and the other paths may either return a single value, applied to all events, or an array with the same size.
+type Paths struct {
+	Identifier string
+	Namespace  string
+	Timestamp  string
+	EventType  string
+}
+
+// ExtractEvents returns one SecretRotationEvent per secret identifier found on the payload.
+// If an event type is found, it is appended to the trigger source as `<triggerSource>/<eventType>`.
+func ExtractEvents(payload string, paths Paths, triggerSource string) ([]events.SecretRotationEvent, error) {
+	if !gjson.Valid(payload) {
+		return nil, errors.New("invalid json")
+	}
+
+	identifiers, err := values(payload, paths.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(identifiers) == 0 {
+		return nil, errors.New("secret not found on event")
+	}
+
+	namespaces, err := optionalValues(payload, paths.Namespace, len(identifiers))
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace path: %w", err)
+	}
+	timestamps, err := optionalValues(payload, paths.Timestamp, len(identifiers))
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp path: %w", err)
+	}
+	eventTypes, err := optionalValues(payload, paths.EventType, len(identifiers))
+	if err != nil {
+		return nil, fmt.Errorf("invalid event type path: %w", err)
+	}
+
+	now := time.Now().Format(TimestampFormat)
+	result := make([]events.SecretRotationEvent, 0, len(identifiers))
+	for i, identifier := range identifiers {
+		event := events.SecretRotationEvent{
+			SecretIdentifier:  identifier,
+			RotationTimestamp: now,
+			TriggerSource:     triggerSource,
+			Namespace:         namespaces[i],
+		}
+		if timestamps[i] != "" {
+			event.RotationTimestamp = timestamps[i]
+		}
+		if eventTypes[i] != "" {
+			event.TriggerSource = fmt.Sprintf("%s/%s", triggerSource, eventTypes[i])
+		}
+		result = append(result, event)
+	}
+
+	return result, nil
+}
+
+// values returns all string values found on path. Arrays are flattened one level.
+func values(payload, path string) ([]string, error) {
+	res := gjson.Get(payload, path)
+	if !res.Exists() {
+		return nil, errors.New("secret not found on event")
+	}
+	if !res.IsArray() {
+		v, err := stringValue(res)
+		if err != nil {
+			return nil, err
+		}
+		return []string{v}, nil
+	}
+
+	elements := res.Array()
+	out := make([]string, 0, len(elements))
+	for _, element := range elements {
+		v, err := stringValue(element)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// optionalValues returns size values for an optional path. Empty values are returned if the path is not set or not found.
+func optionalValues(payload, path string, size int) ([]string, error) {
+	out := make([]string, size)
+	if path == "" {
+		return out, nil
+	}
+	res := gjson.Get(payload, path)
+	if !res.Exists() {
+		return out, nil
+	}
+	if !res.IsArray() {
+		v, err := stringValue(res)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out {
+			out[i] = v
+		}
+		return out, nil
+	}
+
+	elements := res.Array()
+	if len(elements) != size {
+		return nil, fmt.Errorf("expected %d values, found %d", size, len(elements))
+	}
+	for i, element := range elements {
+		v, err := stringValue(element)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func stringValue(res gjson.Result) (string, error) {
+	switch res.Type {
+	case gjson.String, gjson.Number:
+		return res.String(), nil
+	default:
+		return "", fmt.Errorf("value %s must be a string", res.Raw)
+	}
+}
@@ -0,0 +1,92 @@
+package payload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractEvents(t *testing.T) {
+	testCases := []struct {
+		name            string
+		payload         string
+		paths           Paths
+		wantIdentifiers []string
+		wantNamespaces  []string
+		wantTimestamps  []string
+		wantSources     []string
+		wantErr         bool
+	}{
+		{
+			name:            "single identifier",
+			payload:         `[{"data":{"ObjectName":"db-password"}}]`,
+			paths:           Paths{Identifier: "0.data.ObjectName"},
+			wantIdentifiers: []string{"db-password"},
+			wantNamespaces:  []string{""},
+			wantSources:     []string{"Webhook"},
+		},
+		{
+			name:            "batch with per record fields",
+			payload:         `{"type":"rotated","records":[{"name":"a","ns":"one","at":"t1"},{"name":"b","ns":"two","at":"t2"}]}`,
+			paths:           Paths{Identifier: "records.#.name", Namespace: "records.#.ns", Timestamp: "records.#.at", EventType: "type"},
+			wantIdentifiers: []string{"a", "b"},
+			wantNamespaces:  []string{"one", "two"},
+			wantTimestamps:  []string{"t1", "t2"},
+			wantSources:     []string{"Webhook/rotated", "Webhook/rotated"},
+		},
+		{
+			name:            "array of identifiers with shared namespace",
+			payload:         `{"secrets":["a","b","c"],"namespace":"default"}`,
+			paths:           Paths{Identifier: "secrets", Namespace: "namespace"},
+			wantIdentifiers: []string{"a", "b", "c"},
+			wantNamespaces:  []string{"default", "default", "default"},
+			wantSources:     []string{"Webhook", "Webhook", "Webhook"},
+		},
+		{
+			name:    "mismatched array sizes",
+			payload: `{"records":[{"name":"a","ns":"one"},{"name":"b"}]}`,
+			paths:   Paths{Identifier: "records.#.name", Namespace: "records.#.ns"},
+			wantErr: true,
+		},
+		{
+			name:    "identifier not found",
+			payload: `{"records":[]}`,
+			paths:   Paths{Identifier: "records.#.name"},
+			wantErr: true,
+		},
+		{
+			name:    "non string identifier",
+			payload: `{"secret":{"name":"a"}}`,
+			paths:   Paths{Identifier: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			payload: `not json`,
+			paths:   Paths{Identifier: "secret"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ExtractEvents(tc.payload, tc.paths, "Webhook")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, len(tc.wantIdentifiers))
+			for i, event := range got {
+				assert.Equal(t, tc.wantIdentifiers[i], event.SecretIdentifier)
+				assert.Equal(t, tc.wantNamespaces[i], event.Namespace)
+				assert.Equal(t, tc.wantSources[i], event.TriggerSource)
+				assert.NotEmpty(t, event.RotationTimestamp)
+				if tc.wantTimestamps != nil {
+					assert.Equal(t, tc.wantTimestamps[i], event.RotationTimestamp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+
+	"github.com/external-secrets-inc/reloader/internal/events"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodSpecReferences checks if any container, init container or volume of the pod spec
+// references a Secret or ConfigMap named identifier.
+func PodSpecReferences(spec *corev1.PodSpec, identifier string) bool {
+	for _, container := range spec.InitContainers {
+		if containerReferences(container, identifier) {
+			return true
+		}
+	}
+	for _, container := range spec.Containers {
+		if containerReferences(container, identifier) {
+			return true
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volumeReferences(volume, identifier) {
+			return true
+		}
+	}
+	return false
+}
+
+func containerReferences(container corev1.Container, identifier string) bool {
+	for _, env := range container.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == identifier {
+			return true
+		}
+		if env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == identifier {
+			return true
+		}
+	}
+	for _, envFrom := range container.EnvFrom {
+		if envFrom.SecretRef != nil && envFrom.SecretRef.Name == identifier {
+			return true
+		}
+		if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+func volumeReferences(volume corev1.Volume, identifier string) bool {
+	if volume.Secret != nil && volume.Secret.SecretName == identifier {
+		return true
+	}
+	if volume.ConfigMap != nil && volume.ConfigMap.Name == identifier {
+		return true
+	}
+	if volume.Projected == nil {
+		return false
+	}
+	for _, source := range volume.Projected.Sources {
+		if source.Secret != nil && source.Secret.Name == identifier {
+			return true
+		}
+		if source.ConfigMap != nil && source.ConfigMap.Name == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// maxNameLength keeps generated names usable as label values, e.g. the job-name label of Job pods.
+const maxNameLength = 63
+
+// SuffixedName returns name with a short suffix derived from event, truncating name if needed.
+// The same event always yields the same name, so applying an event twice does not create duplicates.
+func SuffixedName(name string, event events.SecretRotationEvent) string {
+	sum := sha1.Sum([]byte(event.SecretIdentifier + "/" + event.TriggerSource + "/" + event.RotationTimestamp))
+	suffix := hex.EncodeToString(sum[:])[:10]
+	if len(name) > maxNameLength-len(suffix)-1 {
+		name = strings.TrimRight(name[:maxNameLength-len(suffix)-1], "-.")
+	}
+	return name + "-" + suffix
+}
@@ -0,0 +1,157 @@
+package poller
+
+import (
+	"context"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/external-secrets-inc/reloader/internal/util/payload"
+	"github.com/external-secrets-inc/reloader/internal/util/state"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultInterval    = 5 * time.Minute
+	defaultConcurrency = 4
+)
+
+// FetchFn returns the current version of every watched secret, keyed by secret identifier.
+// Versions are opaque strings; any difference with the known version is reported as a change.
+type FetchFn func(ctx context.Context) (map[string]string, error)
+
+// Poller periodically fetches secret versions and publishes a rotation event for every secret whose version changed.
+type Poller struct {
+	config        *v1alpha1.PollConfig
+	client        client.Client
+	fetch         FetchFn
+	triggerSource string
+	eventChan     chan events.SecretRotationEvent
+	logger        logr.Logger
+	known         map[string]string
+	loaded        bool
+	baseline      bool
+}
+
+// New creates a Poller. A nil config uses the defaults.
+func New(config *v1alpha1.PollConfig, c client.Client, fetch FetchFn, triggerSource string, eventChan chan events.SecretRotationEvent, logger logr.Logger) *Poller {
+	if config == nil {
+		config = &v1alpha1.PollConfig{}
+	}
+	return &Poller{
+		config:        config,
+		client:        c,
+		fetch:         fetch,
+		triggerSource: triggerSource,
+		eventChan:     eventChan,
+		logger:        logger,
+	}
+}
+
+// Concurrency returns the maximum number of concurrent provider requests allowed by config.
+func Concurrency(config *v1alpha1.PollConfig) int {
+	if config == nil || config.Concurrency <= 0 {
+		return defaultConcurrency
+	}
+	return int(config.Concurrency)
+}
+
+// ForEach calls fn for every item with at most limit concurrent calls. It returns the first error.
+func ForEach[T any](ctx context.Context, limit int, items []T, fn func(ctx context.Context, item T) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(limit)
+	for _, item := range items {
+		g.Go(func() error {
+			return fn(ctx, item)
+		})
+	}
+	return g.Wait()
+}
+
+// Run polls until ctx is done. Errors are logged and the next poll is attempted after the interval.
+func (p *Poller) Run(ctx context.Context) {
+	for {
+		if err := p.Poll(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error(err, "Failed to poll secret versions")
+		}
+		select {
+		case <-time.After(p.nextInterval()):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *Poller) nextInterval() time.Duration {
+	interval := defaultInterval
+	if p.config.Interval != nil {
+		interval = p.config.Interval.Duration
+	}
+	if p.config.Jitter != nil && p.config.Jitter.Duration > 0 {
+		interval += rand.N(p.config.Jitter.Duration)
+	}
+	return interval
+}
+
+// Poll fetches the current versions once and publishes events for secrets that changed since the last poll.
+// Secrets seen for the first time are reported as changed, unless no state was known yet.
+func (p *Poller) Poll(ctx context.Context) error {
+	current, err := p.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if !p.loaded {
+		known, found, err := p.load(ctx)
+		if err != nil {
+			return err
+		}
+		p.known, p.baseline, p.loaded = known, !found, true
+	}
+
+	changed := []string{}
+	if !p.baseline {
+		for identifier, version := range current {
+			if known, ok := p.known[identifier]; !ok || known != version {
+				changed = append(changed, identifier)
+			}
+		}
+	}
+	sort.Strings(changed)
+	p.logger.V(1).Info("Polled secret versions", "secrets", len(current), "changed", len(changed))
+
+	for _, identifier := range changed {
+		event := events.SecretRotationEvent{
+			SecretIdentifier:  identifier,
+			RotationTimestamp: time.Now().Format(payload.TimestampFormat),
+			TriggerSource:     p.triggerSource,
+		}
+		select {
+		case p.eventChan <- event:
+			p.logger.V(1).Info("Published event to eventChan", "Event", event)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// The state is saved after the events were delivered: a crash in between fires them again rather than losing them.
+	p.known, p.baseline = current, false
+	return p.save(ctx)
+}
+
+func (p *Poller) load(ctx context.Context) (map[string]string, bool, error) {
+	if p.config.StateConfigMapRef == nil {
+		return map[string]string{}, false, nil
+	}
+	return state.Load(ctx, p.client, p.config.StateConfigMapRef)
+}
+
+func (p *Poller) save(ctx context.Context) error {
+	if p.config.StateConfigMapRef == nil {
+		return nil
+	}
+	return state.Save(ctx, p.client, p.config.StateConfigMapRef, p.known)
+}
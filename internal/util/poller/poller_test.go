@@ -0,0 +1,78 @@
+package poller
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/events"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPoll(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	config := &v1alpha1.PollConfig{
+		StateConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "reloader-state", Namespace: "default", Key: "aws"},
+	}
+	versions := map[string]string{"db": "v1", "api": "v1"}
+	fetch := func(context.Context) (map[string]string, error) {
+		current := map[string]string{}
+		for k, v := range versions {
+			current[k] = v
+		}
+		return current, nil
+	}
+	eventChan := make(chan events.SecretRotationEvent, 10)
+	p := New(config, c, fetch, "Test", eventChan, logr.Discard())
+
+	// Without a stored state, the first poll only records the versions.
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "reloader-state", Namespace: "default"}, cm))
+	assert.JSONEq(t, `{"db":"v1","api":"v1"}`, cm.Data["aws"])
+
+	versions["db"] = "v2"
+	versions["cache"] = "v1"
+	delete(versions, "api")
+	require.NoError(t, p.Poll(ctx))
+	require.Len(t, eventChan, 2)
+	assert.Equal(t, "cache", (<-eventChan).SecretIdentifier)
+	event := <-eventChan
+	assert.Equal(t, "db", event.SecretIdentifier)
+	assert.Equal(t, "Test", event.TriggerSource)
+
+	require.NoError(t, p.Poll(ctx))
+	assert.Empty(t, eventChan)
+
+	// A restarted poller loads the stored state and detects changes made in between.
+	versions["cache"] = "v2"
+	restarted := New(config, c, fetch, "Test", eventChan, logr.Discard())
+	require.NoError(t, restarted.Poll(ctx))
+	require.Len(t, eventChan, 1)
+	assert.Equal(t, "cache", (<-eventChan).SecretIdentifier)
+}
+
+func TestForEach(t *testing.T) {
+	results := make(chan int, 5)
+	err := ForEach(context.Background(), 2, []int{1, 2, 3, 4, 5}, func(_ context.Context, i int) error {
+		results <- i * 2
+		return nil
+	})
+	require.NoError(t, err)
+	close(results)
+	sum := 0
+	for r := range results {
+		sum += r
+	}
+	assert.Equal(t, 30, sum)
+	assert.Equal(t, defaultConcurrency, Concurrency(nil))
+	assert.Equal(t, 8, Concurrency(&v1alpha1.PollConfig{Concurrency: 8}))
+}
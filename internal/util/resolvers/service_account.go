@@ -0,0 +1,57 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// ServiceAccountToken requests a token for the ServiceAccount through the TokenRequest API.
+// If clientSet is nil, the token is requested from the cluster the reloader runs in.
+// The audiences of the ServiceAccountSelector are used, or defaultAudiences if it has none.
+func ServiceAccountToken(ctx context.Context, clientSet typedcorev1.ServiceAccountsGetter, serviceAccountSelector *v1alpha1.ServiceAccountSelector, expiration time.Duration, defaultAudiences ...string) (string, error) {
+	if clientSet == nil {
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			return "", fmt.Errorf("failed to get default config: %w", err)
+		}
+		if clientSet, err = typedcorev1.NewForConfig(cfg); err != nil {
+			return "", fmt.Errorf("could not create client set: %w", err)
+		}
+	}
+	audiences := serviceAccountSelector.Audiences
+	if len(audiences) == 0 {
+		audiences = defaultAudiences
+	}
+	expirationSeconds := int64(expiration.Seconds())
+	tr, err := clientSet.ServiceAccounts(serviceAccountSelector.Namespace).CreateToken(ctx, serviceAccountSelector.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not create token: %w", err)
+	}
+	return tr.Status.Token, nil
+}
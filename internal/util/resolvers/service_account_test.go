@@ -0,0 +1,57 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestServiceAccountToken(t *testing.T) {
+	testCases := []struct {
+		name          string
+		audiences     []string
+		wantAudiences []string
+	}{
+		{name: "selector audiences", audiences: []string{"vault"}, wantAudiences: []string{"vault"}},
+		{name: "default audiences", wantAudiences: []string{"api://AzureADTokenExchange"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientSet := k8sfake.NewClientset()
+			var got *authenticationv1.TokenRequest
+			clientSet.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				got = action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+				got.Status.Token = "jwt"
+				return true, got, nil
+			})
+			selector := &v1alpha1.ServiceAccountSelector{Name: "reloader", Namespace: "default", Audiences: tc.audiences}
+			token, err := ServiceAccountToken(context.Background(), clientSet.CoreV1(), selector, 15*time.Minute, "api://AzureADTokenExchange")
+			require.NoError(t, err)
+			assert.Equal(t, "jwt", token)
+			assert.Equal(t, tc.wantAudiences, got.Spec.Audiences)
+			assert.Equal(t, int64(900), *got.Spec.ExpirationSeconds)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxShardSize bounds the JSON stored in one ConfigMap key, well below the 1 MiB limit of a ConfigMap.
+// Larger states are split across the referenced ConfigMap and ConfigMaps named after it with a -1, -2... suffix.
+var maxShardSize = 512 * 1024
+
+// Load reads the known versions stored as JSON in the referenced ConfigMap key and its shards.
+// It returns false if the ConfigMap or the key does not exist.
+func Load(ctx context.Context, c client.Client, ref *v1alpha1.ConfigMapKeySelector) (map[string]string, bool, error) {
+	known := map[string]string{}
+	for i := 0; ; i++ {
+		name := shardName(ref, i)
+		cm := &corev1.ConfigMap{}
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ref.Namespace}, cm)
+		if apierrors.IsNotFound(err) {
+			return known, i > 0, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("could not get state configmap %s/%s: %w", ref.Namespace, name, err)
+		}
+		data, ok := cm.Data[ref.Key]
+		if !ok {
+			return known, i > 0, nil
+		}
+		if err := json.Unmarshal([]byte(data), &known); err != nil {
+			return nil, false, fmt.Errorf("could not decode state configmap %s/%s key %s: %w", ref.Namespace, name, ref.Key, err)
+		}
+	}
+}
+
+// Save stores the known versions as JSON in the referenced ConfigMap key, creating the ConfigMap if needed.
+// States larger than maxShardSize are split across shards, and the key is removed from shards no longer needed.
+func Save(ctx context.Context, c client.Client, ref *v1alpha1.ConfigMapKeySelector, known map[string]string) error {
+	shards, err := split(known)
+	if err != nil {
+		return err
+	}
+	for i, data := range shards {
+		if err := saveShard(ctx, c, ref, shardName(ref, i), data); err != nil {
+			return err
+		}
+	}
+	for i := len(shards); ; i++ {
+		removed, err := removeShard(ctx, c, ref, shardName(ref, i))
+		if err != nil || !removed {
+			return err
+		}
+	}
+}
+
+// shardName returns the name of the ConfigMap holding shard i. The first shard is the referenced ConfigMap.
+func shardName(ref *v1alpha1.ConfigMapKeySelector, i int) string {
+	if i == 0 {
+		return ref.Name
+	}
+	return fmt.Sprintf("%s-%d", ref.Name, i)
+}
+
+// split encodes the known versions as JSON shards of at most maxShardSize bytes each.
+// Keys are sorted, so an unchanged state gives the same shards.
+func split(known map[string]string) ([][]byte, error) {
+	keys := make([]string, 0, len(known))
+	for key := range known {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var shards [][]byte
+	shard, size := map[string]string{}, 0
+	flush := func() error {
+		data, err := json.Marshal(shard)
+		if err != nil {
+			return err
+		}
+		shards = append(shards, data)
+		shard, size = map[string]string{}, 0
+		return nil
+	}
+	for _, key := range keys {
+		// Quotes, colon and comma of the JSON entry.
+		entry := len(key) + len(known[key]) + 6
+		if size > 0 && size+entry > maxShardSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		shard[key] = known[key]
+		size += entry
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+func saveShard(ctx context.Context, c client.Client, ref *v1alpha1.ConfigMapKeySelector, name string, data []byte) error {
+	cm := &corev1.ConfigMap{}
+	err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ref.Namespace}, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ref.Namespace},
+			Data:       map[string]string{ref.Key: string(data)},
+		}
+		if err := c.Create(ctx, cm); err != nil {
+			return fmt.Errorf("could not create state configmap %s/%s: %w", ref.Namespace, name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get state configmap %s/%s: %w", ref.Namespace, name, err)
+	}
+	if cm.Data[ref.Key] == string(data) {
+		return nil
+	}
+	patch := client.MergeFrom(cm.DeepCopy())
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[ref.Key] = string(data)
+	if err := c.Patch(ctx, cm, patch); err != nil {
+		return fmt.Errorf("could not update state configmap %s/%s: %w", ref.Namespace, name, err)
+	}
+	return nil
+}
+
+// removeShard removes the key from the named shard. It returns false if there was no such shard.
+func removeShard(ctx context.Context, c client.Client, ref *v1alpha1.ConfigMapKeySelector, name string) (bool, error) {
+	cm := &corev1.ConfigMap{}
+	err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ref.Namespace}, cm)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not get state configmap %s/%s: %w", ref.Namespace, name, err)
+	}
+	if _, ok := cm.Data[ref.Key]; !ok {
+		return false, nil
+	}
+	patch := client.MergeFrom(cm.DeepCopy())
+	delete(cm.Data, ref.Key)
+	if err := c.Patch(ctx, cm, patch); err != nil {
+		return false, fmt.Errorf("could not update state configmap %s/%s: %w", ref.Namespace, name, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,76 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSaveLoad(t *testing.T) {
+	maxShardSize = 100
+	ref := &v1alpha1.ConfigMapKeySelector{Name: "state", Namespace: "reloader", Key: "secrets"}
+	versions := func(n int) map[string]string {
+		known := map[string]string{}
+		for i := range n {
+			known[fmt.Sprintf("default/secret-%02d", i)] = fmt.Sprintf("hash-%02d", i)
+		}
+		return known
+	}
+	testCases := []struct {
+		name       string
+		saved      []map[string]string
+		wantShards int
+	}{
+		{name: "empty", saved: []map[string]string{{}}, wantShards: 1},
+		{name: "single shard", saved: []map[string]string{versions(2)}, wantShards: 1},
+		{name: "several shards", saved: []map[string]string{versions(10)}, wantShards: 4},
+		{name: "shrinking state", saved: []map[string]string{versions(10), versions(2)}, wantShards: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			other := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "state-1", Namespace: "reloader"}, Data: map[string]string{"other": "kept"}}
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(other).Build()
+			_, found, err := Load(ctx, c, ref)
+			require.NoError(t, err)
+			assert.False(t, found)
+
+			for _, known := range tc.saved {
+				require.NoError(t, Save(ctx, c, ref, known))
+			}
+			want := tc.saved[len(tc.saved)-1]
+			got, found, err := Load(ctx, c, ref)
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, want, got)
+
+			shards := 0
+			for i := 0; ; i++ {
+				cm := &corev1.ConfigMap{}
+				err := c.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: shardName(ref, i)}, cm)
+				if apierrors.IsNotFound(err) {
+					break
+				}
+				require.NoError(t, err)
+				if _, ok := cm.Data[ref.Key]; ok {
+					shards++
+				}
+				if i == 1 {
+					assert.Equal(t, "kept", cm.Data["other"], "other keys of shards are kept")
+				}
+			}
+			assert.Equal(t, tc.wantShards, shards)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Build resolves the secret references of a TLSConfig and returns the matching *tls.Config.
+// It returns nil if config is nil.
+func Build(ctx context.Context, c client.Client, config *v1alpha1.TLSConfig) (*tls.Config, error) {
+	if config == nil {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if config.CARef != nil {
+		ca, err := resolvers.SecretKeyRef(ctx, c, config.CARef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, errors.New("CA bundle does not contain any PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (config.CertRef == nil) != (config.KeyRef == nil) {
+		return nil, errors.New("certSecretRef and keySecretRef must be set together")
+	}
+	if config.CertRef != nil {
+		cert, err := resolvers.SecretKeyRef(ctx, c, config.CertRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get client certificate: %w", err)
+		}
+		key, err := resolvers.SecretKeyRef(ctx, c, config.KeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get client key: %w", err)
+		}
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	return tlsConfig, nil
+}
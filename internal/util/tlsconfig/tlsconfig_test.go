@@ -0,0 +1,114 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBuild(t *testing.T) {
+	certPEM, keyPEM := selfSigned(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"ca.crt":  certPEM,
+			"tls.crt": certPEM,
+			"tls.key": keyPEM,
+			"invalid": []byte("not a certificate"),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	ref := func(key string) *v1alpha1.SecretKeySelector {
+		return &v1alpha1.SecretKeySelector{Name: "tls", Namespace: "default", Key: key}
+	}
+
+	testCases := []struct {
+		name      string
+		config    *v1alpha1.TLSConfig
+		wantNil   bool
+		wantCerts int
+		wantRoots bool
+		wantErr   bool
+	}{
+		{
+			name:    "nil config",
+			wantNil: true,
+		},
+		{
+			name:   "server name only",
+			config: &v1alpha1.TLSConfig{ServerName: "kafka.example.com"},
+		},
+		{
+			name:      "ca and client certificate",
+			config:    &v1alpha1.TLSConfig{CARef: ref("ca.crt"), CertRef: ref("tls.crt"), KeyRef: ref("tls.key")},
+			wantCerts: 1,
+			wantRoots: true,
+		},
+		{
+			name:    "invalid ca",
+			config:  &v1alpha1.TLSConfig{CARef: ref("invalid")},
+			wantErr: true,
+		},
+		{
+			name:    "certificate without key",
+			config:  &v1alpha1.TLSConfig{CertRef: ref("tls.crt")},
+			wantErr: true,
+		},
+		{
+			name:    "missing secret key",
+			config:  &v1alpha1.TLSConfig{CARef: ref("missing")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Build(context.Background(), c, tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tc.config.ServerName, got.ServerName)
+			assert.Len(t, got.Certificates, tc.wantCerts)
+			assert.Equal(t, tc.wantRoots, got.RootCAs != nil)
+		})
+	}
+}
+
+func selfSigned(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
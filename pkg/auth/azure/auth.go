@@ -0,0 +1,88 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"k8s.io/client-go/kubernetes"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	v1alpha1 "github.com/external-secrets-inc/reloader/api/v1alpha1"
+	"github.com/external-secrets-inc/reloader/internal/util/resolvers"
+)
+
+const (
+	// TokenExchangeAudience is the audience Microsoft Entra expects on federated ServiceAccount tokens.
+	TokenExchangeAudience = "api://AzureADTokenExchange"
+
+	envTenantID = "AZURE_TENANT_ID"
+	envClientID = "AZURE_CLIENT_ID"
+)
+
+// NewTokenCredential returns the Azure credential configured by auth.
+func NewTokenCredential(ctx context.Context, auth *v1alpha1.AzureKeyVaultAuth, kube kclient.Client) (azcore.TokenCredential, error) {
+	if auth == nil || (auth.ClientSecret == nil) == (auth.WorkloadIdentity == nil) {
+		return nil, errors.New("exactly one of clientSecret or workloadIdentity must be set")
+	}
+	clientOptions := azcore.ClientOptions{}
+	if auth.AuthorityHost != "" {
+		clientOptions.Cloud = cloud.Configuration{ActiveDirectoryAuthorityHost: auth.AuthorityHost}
+	}
+
+	if cs := auth.ClientSecret; cs != nil {
+		if auth.TenantID == "" {
+			return nil, errors.New("tenantID is required for clientSecret auth")
+		}
+		clientID, err := resolvers.SecretKeyRef(ctx, kube, &cs.ClientIDRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get client ID: %w", err)
+		}
+		clientSecret, err := resolvers.SecretKeyRef(ctx, kube, &cs.ClientSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not get client secret: %w", err)
+		}
+		return azidentity.NewClientSecretCredential(auth.TenantID, clientID, clientSecret, &azidentity.ClientSecretCredentialOptions{ClientOptions: clientOptions})
+	}
+
+	wi := auth.WorkloadIdentity
+	if wi.ServiceAccountRef == nil {
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: clientOptions,
+			ClientID:      wi.ClientID,
+			TenantID:      auth.TenantID,
+		})
+	}
+
+	tenantID := valueOrEnv(auth.TenantID, envTenantID)
+	clientID := valueOrEnv(wi.ClientID, envClientID)
+	if tenantID == "" || clientID == "" {
+		return nil, errors.New("tenantID and clientID are required for workloadIdentity auth with a serviceAccountRef")
+	}
+	cfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sa := wi.ServiceAccountRef
+	getAssertion := func(ctx context.Context) (string, error) {
+		return resolvers.ServiceAccountToken(ctx, clientset.CoreV1(), sa, 15*time.Minute, TokenExchangeAudience)
+	}
+	return azidentity.NewClientAssertionCredential(tenantID, clientID, getAssertion, &azidentity.ClientAssertionCredentialOptions{ClientOptions: clientOptions})
+}
+
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}